@@ -0,0 +1,15 @@
+package client
+
+import "context"
+
+// AccountsService handles the accounts the API token has access to.
+type AccountsService service
+
+// List returns every account the API token can access.
+func (s *AccountsService) List(ctx context.Context) ([]Account, error) {
+	return ListAll(ctx, func(ctx context.Context, _ Cursor) ([]Account, error) {
+		var accounts []Account
+		err := s.client.Get(ctx, getAllAccountsPath(), &accounts)
+		return accounts, err
+	}, SinglePage[Account]())
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAccountsService_List(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts")
+		w.Write([]byte(`[{"id":1,"name":"Primary","access_levels":[1000]},{"id":2,"name":"Shared","access_levels":[10]}]`))
+	})
+
+	accounts, err := client.Accounts.List(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(accounts) != 2 {
+		t.Fatalf("Expected 2 accounts, got %d", len(accounts))
+	}
+
+	if accounts[0].Name != "Primary" || accounts[0].AccessLevels[0] != 1000 {
+		t.Errorf("Expected Primary account with access level 1000, got %+v", accounts[0])
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import "fmt"
+
+// API identifies a family of Mailtrap endpoints served from the same host.
+// Every request declares the family it belongs to and the client resolves it
+// to the configured base URL for that family.
+type API int
+
+const (
+	// GeneralAPI serves account, project, inbox, message and sending domain
+	// management endpoints under /api/accounts.
+	GeneralAPI API = iota
+
+	// SendingAPI serves transactional email sending (/api/send, /api/batch).
+	SendingAPI
+
+	// BulkAPI serves bulk email sending (/api/send, /api/batch) on the bulk
+	// stream.
+	BulkAPI
+
+	// SandboxAPI serves email testing sends into an inbox
+	// (/api/send/{inbox_id}, /api/batch/{inbox_id}).
+	SandboxAPI
+)
+
+// defaultAPIURLs maps each API family to its public Mailtrap base URL.
+var defaultAPIURLs = map[API]string{
+	GeneralAPI: defaultBaseURL,
+	SendingAPI: sendingAPIURL,
+	BulkAPI:    bulkAPIURL,
+	SandboxAPI: sandboxAPIURL,
+}
+
+// String returns a readable name for the API family.
+func (a API) String() string {
+	switch a {
+	case GeneralAPI:
+		return "general"
+	case SendingAPI:
+		return "sending"
+	case BulkAPI:
+		return "bulk"
+	case SandboxAPI:
+		return "sandbox"
+	}
+	return fmt.Sprintf("API(%d)", int(a))
+}
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const openAPIDir = "../../docs/mailtrap-open-api"
+
+// openAPIServer is the subset of an OpenAPI server object used by the tests.
+type openAPIServer struct {
+	URL string `yaml:"url"`
+}
+
+// openAPIDocument is the subset of an OpenAPI document needed to enumerate
+// routes and the host each one is served from.
+type openAPIDocument struct {
+	Servers []openAPIServer                   `yaml:"servers"`
+	Paths   map[string]map[string]interface{} `yaml:"paths"`
+}
+
+// openAPIRoute is a single operation declared in a spec.
+type openAPIRoute struct {
+	Spec      string
+	Method    string
+	Path      string
+	ServerURL string
+}
+
+var openAPIMethods = []string{"get", "post", "put", "patch", "delete"}
+
+// loadOpenAPIRoutes returns every operation of every bundled spec together
+// with the server URL that serves it.
+func loadOpenAPIRoutes(t *testing.T) []openAPIRoute {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(openAPIDir, "*.yml"))
+	if err != nil {
+		t.Fatalf("Failed to list OpenAPI specs: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("No OpenAPI specs found in %s", openAPIDir)
+	}
+
+	var routes []openAPIRoute
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", file, err)
+		}
+
+		var doc openAPIDocument
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			t.Fatalf("Failed to parse %s: %v", file, err)
+		}
+		if len(doc.Servers) == 0 {
+			t.Fatalf("Spec %s declares no servers", file)
+		}
+
+		for path, item := range doc.Paths {
+			serverURL := doc.Servers[0].URL
+			if servers, ok := item["servers"].([]interface{}); ok && len(servers) > 0 {
+				if server, ok := servers[0].(map[string]interface{}); ok {
+					serverURL, _ = server["url"].(string)
+				}
+			}
+
+			for _, method := range openAPIMethods {
+				if _, ok := item[method]; !ok {
+					continue
+				}
+				routes = append(routes, openAPIRoute{
+					Spec:      filepath.Base(file),
+					Method:    strings.ToUpper(method),
+					Path:      path,
+					ServerURL: serverURL,
+				})
+			}
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	return routes
+}
+
+// apiForServerURL maps a spec server URL to the API family whose default
+// base URL it is.
+func apiForServerURL(serverURL string) (API, bool) {
+	for api, baseURL := range defaultAPIURLs {
+		if baseURL == serverURL {
+			return api, true
+		}
+	}
+	return 0, false
+}
+
+var pathParamPattern = regexp.MustCompile(`\{[^}]+\}`)
+
+type recordedRequest struct {
+	api    API
+	method string
+	path   string
+}
+
+func TestAPI_String(t *testing.T) {
+	tests := map[API]string{
+		GeneralAPI: "general",
+		SendingAPI: "sending",
+		BulkAPI:    "bulk",
+		SandboxAPI: "sandbox",
+		API(42):    "API(42)",
+	}
+
+	for api, expected := range tests {
+		if api.String() != expected {
+			t.Errorf("Expected %s, got %s", expected, api.String())
+		}
+	}
+}
+
+func TestDefaultAPIURLs(t *testing.T) {
+	client := NewClient("test-token")
+
+	for _, api := range []API{GeneralAPI, SendingAPI, BulkAPI, SandboxAPI} {
+		if client.APIURL(api) == "" {
+			t.Errorf("Expected a default base URL for the %s API", api)
+		}
+		if client.APIURL(api) != defaultAPIURLs[api] {
+			t.Errorf("Expected %s API URL %s, got %s", api, defaultAPIURLs[api], client.APIURL(api))
+		}
+	}
+}
+
+func TestDoRequest_UnknownAPI(t *testing.T) {
+	client := NewClient("test-token")
+
+	if err := client.Do(context.Background(), API(42), http.MethodGet, "/test", nil, nil); err == nil {
+		t.Fatal("Expected error for an unknown API family")
+	}
+}
+
+// TestDoRequest_OpenAPIRoutes sends every operation declared in the bundled
+// OpenAPI specs through the client and checks that it reaches the host the
+// spec says serves it, with the path left untouched.
+func TestDoRequest_OpenAPIRoutes(t *testing.T) {
+	routes := loadOpenAPIRoutes(t)
+
+	var mu sync.Mutex
+	var received []recordedRequest
+
+	client := NewClient("test-token")
+	client.SetRetryPolicy(0, 0)
+	client.SetRateLimit(0, 0)
+
+	for _, api := range []API{GeneralAPI, SendingAPI, BulkAPI, SandboxAPI} {
+		api := api
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			received = append(received, recordedRequest{api: api, method: r.Method, path: r.URL.Path})
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		client.SetAPIURL(api, server.URL)
+	}
+
+	for _, route := range routes {
+		route := route
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			api, ok := apiForServerURL(route.ServerURL)
+			if !ok {
+				t.Fatalf("%s: server %s does not match any API family default URL", route.Spec, route.ServerURL)
+			}
+
+			endpoint := pathParamPattern.ReplaceAllString(route.Path, "1")
+
+			mu.Lock()
+			received = nil
+			mu.Unlock()
+
+			if err := client.Do(context.Background(), api, route.Method, endpoint, nil, nil); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if len(received) != 1 {
+				t.Fatalf("Expected exactly 1 request, got %d", len(received))
+			}
+
+			got := received[0]
+			if got.api != api {
+				t.Errorf("Expected request to reach the %s API host, reached %s", api, got.api)
+			}
+			if got.method != route.Method {
+				t.Errorf("Expected method %s, got %s", route.Method, got.method)
+			}
+			if got.path != endpoint {
+				t.Errorf("Expected path %s, got %s", endpoint, got.path)
+			}
+		})
+	}
+}
+
+// TestOpenAPIRoutes_SandboxBatch guards against the batch endpoint for
+// testing inboxes being routed to the general host.
+func TestOpenAPIRoutes_SandboxBatch(t *testing.T) {
+	for _, route := range loadOpenAPIRoutes(t) {
+		if route.Path != "/api/batch/{inbox_id}" && route.Path != "/api/send/{inbox_id}" {
+			continue
+		}
+
+		api, ok := apiForServerURL(route.ServerURL)
+		if !ok || api != SandboxAPI {
+			t.Errorf("Expected %s to be served by the sandbox API, got %s", route.Path, route.ServerURL)
+		}
+	}
+}
+
+func TestDoRequest_BulkAPI(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.SetBaseURL("http://general.invalid")
+	client.SetBulkAPIURL(server.URL)
+
+	if err := client.Do(context.Background(), BulkAPI, http.MethodPost, "/api/batch", map[string]string{}, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotPath != "/api/batch" {
+		t.Errorf("Expected path /api/batch, got %s", gotPath)
+	}
+}
@@ -0,0 +1,316 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CassetteModeEnvVar selects the mode of cassette transports created with
+// CassetteModeFromEnv.
+const CassetteModeEnvVar = "MAILTRAP_CASSETTE_MODE"
+
+// CassetteMode controls whether a CassetteTransport records, replays or
+// simply forwards requests.
+type CassetteMode string
+
+const (
+	// CassettePassthrough forwards every request and records nothing.
+	CassettePassthrough CassetteMode = "passthrough"
+
+	// CassetteRecord forwards every request and records the scrubbed
+	// interaction. Save writes the cassette file.
+	CassetteRecord CassetteMode = "record"
+
+	// CassetteReplay answers requests from the cassette file without
+	// touching the network.
+	CassetteReplay CassetteMode = "replay"
+)
+
+// recordedHeaders are the response headers kept in a cassette. Everything
+// else, including cookies and request IDs, is dropped on record.
+var recordedHeaders = []string{"Content-Type", "Retry-After"}
+
+// minSecretLength is the length below which a header value is not treated
+// as a secret to scrub, so short test tokens do not mangle recorded bodies.
+const minSecretLength = 8
+
+// CassetteModeFromEnv returns the mode set in MAILTRAP_CASSETTE_MODE, or
+// fallback when it is unset.
+func CassetteModeFromEnv(fallback CassetteMode) (CassetteMode, error) {
+	value := strings.TrimSpace(os.Getenv(CassetteModeEnvVar))
+	if value == "" {
+		return fallback, nil
+	}
+
+	switch mode := CassetteMode(strings.ToLower(value)); mode {
+	case CassettePassthrough, CassetteRecord, CassetteReplay:
+		return mode, nil
+	}
+	return "", fmt.Errorf("%s must be one of record, replay or passthrough, got %q", CassetteModeEnvVar, value)
+}
+
+// Cassette is a recorded sequence of HTTP interactions.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+// Interaction is a single recorded request and the response it received.
+type Interaction struct {
+	Request  RecordedRequest  `yaml:"request"`
+	Response RecordedResponse `yaml:"response"`
+}
+
+// RecordedRequest identifies a request. Path includes the query string but
+// not the host, so a cassette replays against any base URL.
+type RecordedRequest struct {
+	Method string `yaml:"method"`
+	Path   string `yaml:"path"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+// RecordedResponse is the scrubbed response to a recorded request.
+type RecordedResponse struct {
+	StatusCode int               `yaml:"status_code"`
+	Headers    map[string]string `yaml:"headers,omitempty"`
+	Body       string            `yaml:"body,omitempty"`
+}
+
+// CassetteTransport is an http.RoundTripper that records interactions to a
+// cassette file or replays them from one. Request headers are never
+// recorded, and the API token and credentials are scrubbed from paths and
+// bodies before anything is kept.
+//
+// On replay, each request is answered by the first interaction not yet
+// played with the same method, path and body, so a session that reads the
+// same resource several times replays each response in order.
+type CassetteTransport struct {
+	mode CassetteMode
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	played   []bool
+}
+
+// NewCassetteTransport returns a transport for the cassette at path. In
+// replay mode the cassette is loaded immediately. next handles requests in
+// record and passthrough mode; http.DefaultTransport is used when it is nil.
+func NewCassetteTransport(path string, mode CassetteMode, next http.RoundTripper) (*CassetteTransport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &CassetteTransport{mode: mode, path: path, next: next}
+
+	switch mode {
+	case CassetteReplay:
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := yaml.Unmarshal(raw, &t.cassette); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+		t.played = make([]bool, len(t.cassette.Interactions))
+	case CassetteRecord, CassettePassthrough:
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q", mode)
+	}
+
+	return t, nil
+}
+
+// Mode returns the mode of the transport.
+func (t *CassetteTransport) Mode() CassetteMode {
+	return t.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch t.mode {
+	case CassetteReplay:
+		return t.replay(req)
+	case CassetteRecord:
+		return t.record(req)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// Save writes the recorded interactions to the cassette file. It does
+// nothing outside of record mode.
+func (t *CassetteTransport) Save() error {
+	if t.mode != CassetteRecord {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	raw, err := yaml.Marshal(&t.cassette)
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(t.path, raw, 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// Unplayed returns the number of interactions not replayed yet. A replayed
+// session that ends with unplayed interactions sent fewer requests than
+// were recorded.
+func (t *CassetteTransport) Unplayed() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n := 0
+	for _, played := range t.played {
+		if !played {
+			n++
+		}
+	}
+	return n
+}
+
+func (t *CassetteTransport) record(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	secrets := requestSecrets(req)
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   scrubSecrets(req.URL.RequestURI(), secrets),
+			Body:   scrubBody(body, secrets),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Body:       scrubBody(respBody, secrets),
+		},
+	}
+	for _, key := range recordedHeaders {
+		if value := resp.Header.Get(key); value != "" {
+			if interaction.Response.Headers == nil {
+				interaction.Response.Headers = map[string]string{}
+			}
+			interaction.Response.Headers[key] = value
+		}
+	}
+
+	t.mu.Lock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction)
+	t.mu.Unlock()
+
+	return resp, nil
+}
+
+func (t *CassetteTransport) replay(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := requestSecrets(req)
+	path := scrubSecrets(req.URL.RequestURI(), secrets)
+	body = []byte(scrubBody(body, secrets))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.cassette.Interactions {
+		recorded := interaction.Request
+		if t.played[i] || recorded.Method != req.Method || recorded.Path != path || recorded.Body != string(body) {
+			continue
+		}
+		t.played[i] = true
+
+		header := http.Header{}
+		for key, value := range interaction.Response.Headers {
+			header.Set(key, value)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("cassette %s has no unplayed interaction for %s %s", t.path, req.Method, path)
+}
+
+// readRequestBody reads the request body and replaces it so it can still be
+// sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// requestSecrets returns the credentials a request carries in its headers.
+func requestSecrets(req *http.Request) []string {
+	var secrets []string
+	for key := range sensitiveHeaders {
+		value := req.Header.Get(key)
+		value = strings.TrimSpace(strings.TrimPrefix(value, "Bearer "))
+		if len(value) >= minSecretLength {
+			secrets = append(secrets, value)
+		}
+	}
+	return secrets
+}
+
+// scrubSecrets replaces every occurrence of a secret in s.
+func scrubSecrets(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redactedValue)
+	}
+	return s
+}
+
+// scrubBody masks credentials in a JSON body and any literal occurrence of
+// the request's secrets. The JSON is re-encoded with sorted keys, so bodies
+// compare equal regardless of field order.
+func scrubBody(body []byte, secrets []string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	return scrubSecrets(redactBody(body), secrets)
+}
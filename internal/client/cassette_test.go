@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const cassetteTestToken = "0123456789abcdef0123456789abcdef"
+
+// newCassetteTestClient returns a client sending requests through a
+// cassette transport, with retries and rate limiting disabled.
+func newCassetteTestClient(t *testing.T, baseURL string, transport *CassetteTransport) *Client {
+	t.Helper()
+
+	client := NewClient(cassetteTestToken)
+	client.SetBaseURL(baseURL)
+	client.SetRetryPolicy(0, 0)
+	client.SetRateLimit(0, 0)
+	client.SetTransport(transport)
+	return client
+}
+
+func TestCassetteTransport_RecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/accounts/1/inboxes/100":
+			w.Write([]byte(`{"id":100,"name":"QA","username":"a1b2c3","password":"smtp-secret"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/accounts/1/inboxes/404":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"Not Found"}`))
+		case r.Method == http.MethodPatch:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"errors":{"name":["is too short (minimum is 2 characters)"]},"echo":"` + r.Header.Get("Api-Token") + `"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "inbox.yaml")
+
+	// Record a session against the live server.
+	recorder, err := NewCassetteTransport(path, CassetteRecord, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client := newCassetteTestClient(t, server.URL, recorder)
+
+	inbox, err := client.Inboxes.Get(context.Background(), 1, 100)
+	if err != nil {
+		t.Fatalf("Expected no error while recording, got %v", err)
+	}
+	if inbox.Password != "smtp-secret" {
+		t.Errorf("Expected the live response to be returned unchanged, got %+v", inbox)
+	}
+	client.Inboxes.Get(context.Background(), 1, 404)
+	client.Inboxes.Update(context.Background(), 1, 100, InboxParams{Name: "Q"})
+
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Expected cassette to be saved, got %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	for _, secret := range []string{cassetteTestToken, "smtp-secret", "a1b2c3", "session=abc"} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("Expected %q to be scrubbed from the cassette:\n%s", secret, raw)
+		}
+	}
+
+	// Replay it without a server.
+	server.Close()
+	player, err := NewCassetteTransport(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client = newCassetteTestClient(t, "https://mailtrap.invalid", player)
+
+	inbox, err = client.Inboxes.Get(context.Background(), 1, 100)
+	if err != nil {
+		t.Fatalf("Expected no error while replaying, got %v", err)
+	}
+	if inbox.ID != 100 || inbox.Name != "QA" || inbox.Password != redactedValue {
+		t.Errorf("Expected the scrubbed inbox, got %+v", inbox)
+	}
+
+	_, err = client.Inboxes.Get(context.Background(), 1, 404)
+	if !IsNotFound(err) {
+		t.Errorf("Expected a replayed 404, got %v", err)
+	}
+
+	_, err = client.Inboxes.Update(context.Background(), 1, 100, InboxParams{Name: "Q"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity || len(apiErr.FieldErrors["name"]) != 1 {
+		t.Errorf("Expected a replayed validation error, got %v", err)
+	}
+
+	if n := player.Unplayed(); n != 0 {
+		t.Errorf("Expected every interaction to be replayed, got %d left", n)
+	}
+}
+
+func TestCassetteTransport_ReplayInOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "projects.yaml")
+	cassette := `
+interactions:
+  - request:
+      method: GET
+      path: /api/accounts/1/projects/10
+    response:
+      status_code: 200
+      body: '{"id":10,"name":"Before"}'
+  - request:
+      method: GET
+      path: /api/accounts/1/projects/10
+    response:
+      status_code: 200
+      body: '{"id":10,"name":"After"}'
+`
+	if err := os.WriteFile(path, []byte(cassette), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	player, err := NewCassetteTransport(path, CassetteReplay, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client := newCassetteTestClient(t, "https://mailtrap.invalid", player)
+
+	for _, want := range []string{"Before", "After"} {
+		project, err := client.Projects.Get(context.Background(), 1, 10)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if project.Name != want {
+			t.Errorf("Expected project %s, got %s", want, project.Name)
+		}
+	}
+
+	_, err = client.Projects.Get(context.Background(), 1, 10)
+	if err == nil || !strings.Contains(err.Error(), "no unplayed interaction for GET /api/accounts/1/projects/10") {
+		t.Errorf("Expected an exhausted cassette error, got %v", err)
+	}
+}
+
+func TestCassetteTransport_Passthrough(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "passthrough.yaml")
+	transport, err := NewCassetteTransport(path, CassettePassthrough, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := newCassetteTestClient(t, server.URL, transport).Accounts.List(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := transport.Save(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected no cassette to be written in passthrough mode, got %v", err)
+	}
+}
+
+func TestNewCassetteTransport_MissingCassette(t *testing.T) {
+	_, err := NewCassetteTransport(filepath.Join(t.TempDir(), "missing.yaml"), CassetteReplay, nil)
+	if err == nil {
+		t.Error("Expected an error for a missing cassette")
+	}
+}
+
+func TestCassetteModeFromEnv(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected CassetteMode
+		hasError bool
+	}{
+		{"", CassetteReplay, false},
+		{"record", CassetteRecord, false},
+		{" Replay ", CassetteReplay, false},
+		{"passthrough", CassettePassthrough, false},
+		{"rewind", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(CassetteModeEnvVar, tt.value)
+
+			mode, err := CassetteModeFromEnv(CassetteReplay)
+			if tt.hasError {
+				if err == nil {
+					t.Errorf("Expected error for %q, got mode %s", tt.value, mode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if mode != tt.expected {
+				t.Errorf("Expected mode %s, got %s", tt.expected, mode)
+			}
+		})
+	}
+}
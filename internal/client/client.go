@@ -2,85 +2,248 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
 )
 
 const (
-	defaultBaseURL   = "https://mailtrap.io"
-	sendingAPIURL    = "https://send.api.mailtrap.io"
-	sandboxAPIURL    = "https://sandbox.api.mailtrap.io"
+	defaultBaseURL = "https://mailtrap.io"
+	sendingAPIURL  = "https://send.api.mailtrap.io"
+	bulkAPIURL     = "https://bulk.api.mailtrap.io"
+	sandboxAPIURL  = "https://sandbox.api.mailtrap.io"
+
+	defaultUserAgent = "terraform-provider-mailtrap"
+
+	// defaultTimeout is the time limit of a single request attempt.
+	defaultTimeout = 30 * time.Second
 )
 
 // Client represents a Mailtrap API client
 type Client struct {
-	baseURL    string
+	apiURLs    map[API]string
 	apiToken   string
+	userAgent  string
 	httpClient *http.Client
+
+	maxRetries   int
+	retryMinWait time.Duration
+	retryMaxWait time.Duration
+
+	limiter *rate.Limiter
+
+	// Typed services for the Mailtrap API
+	Accounts       *AccountsService
+	Projects       *ProjectsService
+	Inboxes        *InboxesService
+	SendingDomains *SendingDomainsService
+	Messages       *MessagesService
 }
 
 // NewClient creates a new Mailtrap API client
 func NewClient(apiToken string) *Client {
-	return &Client{
-		baseURL:  defaultBaseURL,
-		apiToken: apiToken,
+	apiURLs := make(map[API]string, len(defaultAPIURLs))
+	for api, baseURL := range defaultAPIURLs {
+		apiURLs[api] = baseURL
+	}
+
+	c := &Client{
+		apiURLs:   apiURLs,
+		apiToken:  apiToken,
+		userAgent: defaultUserAgent,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: defaultTimeout,
 		},
+		maxRetries:   defaultMaxRetries,
+		retryMinWait: defaultRetryMinWait,
+		retryMaxWait: defaultRetryMaxWait,
+		limiter:      rate.NewLimiter(defaultRateLimit, defaultRateLimitBurst),
 	}
+
+	c.Accounts = &AccountsService{client: c}
+	c.Projects = &ProjectsService{client: c}
+	c.Inboxes = &InboxesService{client: c}
+	c.SendingDomains = &SendingDomainsService{client: c}
+	c.Messages = &MessagesService{client: c}
+
+	return c
+}
+
+// SetAPIURL sets a custom base URL for an API family
+func (c *Client) SetAPIURL(api API, baseURL string) {
+	if c.apiURLs == nil {
+		c.apiURLs = make(map[API]string)
+	}
+	c.apiURLs[api] = baseURL
+}
+
+// APIURL returns the base URL used for an API family
+func (c *Client) APIURL(api API) string {
+	return c.apiURLs[api]
+}
+
+// SetUserAgent sets the User-Agent header sent with every request
+func (c *Client) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// SetTimeout sets the time limit of a single request attempt, including
+// reading the response body. Retries are not included; bound a whole call,
+// retries and all, through the deadline of its context.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.httpClient.Timeout = timeout
 }
 
-// SetBaseURL sets a custom base URL for the client
+// SetTransport replaces the transport used to send requests, for example
+// with a CassetteTransport in tests
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.httpClient.Transport = transport
+}
+
+// SetBaseURL sets a custom base URL for the general API
 func (c *Client) SetBaseURL(baseURL string) {
-	c.baseURL = baseURL
+	c.SetAPIURL(GeneralAPI, baseURL)
 }
 
-// doRequest performs an HTTP request with proper authentication
-func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Response, error) {
-	var reqBody io.Reader
+// SetSendingAPIURL sets a custom base URL for the transactional sending API
+func (c *Client) SetSendingAPIURL(sendingURL string) {
+	c.SetAPIURL(SendingAPI, sendingURL)
+}
+
+// SetBulkAPIURL sets a custom base URL for the bulk sending API
+func (c *Client) SetBulkAPIURL(bulkURL string) {
+	c.SetAPIURL(BulkAPI, bulkURL)
+}
+
+// SetSandboxAPIURL sets a custom base URL for the sandbox (email testing) sending API
+func (c *Client) SetSandboxAPIURL(sandboxURL string) {
+	c.SetAPIURL(SandboxAPI, sandboxURL)
+}
+
+// doRequest performs an HTTP request with proper authentication. The request
+// is bound to ctx, so cancelling ctx or hitting its deadline aborts it.
+// Every attempt goes through the client-side rate limiter, and transient
+// failures are retried according to the client's retry policy. Requests and
+// responses are logged, redacted, through the mailtrap_client subsystem.
+func (c *Client) doRequest(ctx context.Context, api API, method, endpoint string, body interface{}) (*http.Response, error) {
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	// Determine the base URL based on the endpoint
-	baseURL := c.baseURL
-	if endpoint == "/api/send" || endpoint == "/api/batch" {
-		baseURL = sendingAPIURL
-	} else if len(endpoint) > 10 && endpoint[:10] == "/api/send/" {
-		baseURL = sandboxAPIURL
+	baseURL, ok := c.apiURLs[api]
+	if !ok {
+		return nil, fmt.Errorf("no base URL configured for %s API", api)
 	}
 
-	fullURL, err := url.JoinPath(baseURL, endpoint)
+	fullURL, err := joinURL(baseURL, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to construct URL: %w", err)
 	}
 
-	req, err := http.NewRequest(method, fullURL, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	ctx = c.newLogContext(ctx)
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx, method, endpoint); err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		var reqBody io.Reader
+		if jsonBody != nil {
+			reqBody = bytes.NewReader(jsonBody)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		req.Header.Set("Api-Token", c.apiToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+
+		logRequest(ctx, req, jsonBody, attempt)
+
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			logRequestError(ctx, req, err, time.Since(start))
+		} else {
+			logResponse(ctx, req, resp, time.Since(start))
+		}
+
+		if attempt >= c.maxRetries || !shouldRetry(ctx, method, resp, err) {
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+			return resp, nil
+		}
+
+		wait := c.retryWait(attempt, resp)
+		if resp != nil {
+			// Drain the body so the underlying connection can be reused.
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.SubsystemDebug(ctx, logSubsystem, "Retrying Mailtrap API request", map[string]interface{}{
+			"method":  method,
+			"url":     fullURL,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("failed to execute request: %w", ctx.Err())
+		case <-timer.C:
+		}
 	}
+}
 
-	req.Header.Set("Api-Token", c.apiToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+// joinURL appends endpoint, which may carry a query string, to baseURL.
+func joinURL(baseURL, endpoint string) (string, error) {
+	ref, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
 
-	resp, err := c.httpClient.Do(req)
+	u, err := url.Parse(baseURL)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return "", err
 	}
 
-	return resp, nil
+	u = u.JoinPath(ref.Path)
+	u.RawQuery = ref.RawQuery
+	return u.String(), nil
 }
 
-// handleResponse processes the HTTP response and unmarshals JSON if needed
+// withQuery appends the encoded query to endpoint, if there is one.
+func withQuery(endpoint string, query url.Values) string {
+	if len(query) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + query.Encode()
+}
+
+// handleResponse processes the HTTP response and unmarshals JSON if needed.
+// Error responses are returned as *APIError.
 func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 	defer resp.Body.Close()
 
@@ -90,23 +253,7 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 	}
 
 	if resp.StatusCode >= 400 {
-		var errorResp struct {
-			Error   string `json:"error"`
-			Errors  interface{} `json:"errors"`
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(body, &errorResp); err == nil {
-			if errorResp.Error != "" {
-				return fmt.Errorf("API error (%d): %s", resp.StatusCode, errorResp.Error)
-			}
-			if errorResp.Message != "" {
-				return fmt.Errorf("API error (%d): %s", resp.StatusCode, errorResp.Message)
-			}
-			if errorResp.Errors != "" {
-				return fmt.Errorf("API error (%d): %v", resp.StatusCode, errorResp.Errors)
-			}
-		}
-		return fmt.Errorf("API error (%d): %s", resp.StatusCode, string(body))
+		return newAPIError(resp.StatusCode, body)
 	}
 
 	if result != nil && len(body) > 0 {
@@ -118,38 +265,32 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 	return nil
 }
 
-// Get performs a GET request
-func (c *Client) Get(endpoint string, result interface{}) error {
-	resp, err := c.doRequest("GET", endpoint, nil)
+// Do performs a request against the given API family and decodes the JSON
+// response into result, if result is not nil
+func (c *Client) Do(ctx context.Context, api API, method, endpoint string, body, result interface{}) error {
+	resp, err := c.doRequest(ctx, api, method, endpoint, body)
 	if err != nil {
 		return err
 	}
 	return c.handleResponse(resp, result)
 }
 
-// Post performs a POST request
-func (c *Client) Post(endpoint string, body, result interface{}) error {
-	resp, err := c.doRequest("POST", endpoint, body)
-	if err != nil {
-		return err
-	}
-	return c.handleResponse(resp, result)
+// Get performs a GET request against the general API
+func (c *Client) Get(ctx context.Context, endpoint string, result interface{}) error {
+	return c.Do(ctx, GeneralAPI, http.MethodGet, endpoint, nil, result)
 }
 
-// Patch performs a PATCH request
-func (c *Client) Patch(endpoint string, body, result interface{}) error {
-	resp, err := c.doRequest("PATCH", endpoint, body)
-	if err != nil {
-		return err
-	}
-	return c.handleResponse(resp, result)
+// Post performs a POST request against the general API
+func (c *Client) Post(ctx context.Context, endpoint string, body, result interface{}) error {
+	return c.Do(ctx, GeneralAPI, http.MethodPost, endpoint, body, result)
 }
 
-// Delete performs a DELETE request
-func (c *Client) Delete(endpoint string, result interface{}) error {
-	resp, err := c.doRequest("DELETE", endpoint, nil)
-	if err != nil {
-		return err
-	}
-	return c.handleResponse(resp, result)
+// Patch performs a PATCH request against the general API
+func (c *Client) Patch(ctx context.Context, endpoint string, body, result interface{}) error {
+	return c.Do(ctx, GeneralAPI, http.MethodPatch, endpoint, body, result)
+}
+
+// Delete performs a DELETE request against the general API
+func (c *Client) Delete(ctx context.Context, endpoint string, result interface{}) error {
+	return c.Do(ctx, GeneralAPI, http.MethodDelete, endpoint, nil, result)
 }
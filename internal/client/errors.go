@@ -0,0 +1,156 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is returned when the Mailtrap API responds with a 4xx or 5xx
+// status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Body is the raw response body.
+	Body string
+
+	// Message is the human readable error message extracted from the body.
+	Message string
+
+	// Errors holds general error messages that are not tied to a field,
+	// for example {"errors": "Unauthorized"} or {"errors": ["..."]}.
+	Errors []string
+
+	// FieldErrors holds validation errors keyed by field name, for example
+	// {"errors": {"name": ["is too short"]}}.
+	FieldErrors map[string][]string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// ErrorResponse represents an API error response
+type ErrorResponse struct {
+	Error   string      `json:"error,omitempty"`
+	Errors  interface{} `json:"errors,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// newAPIError builds an APIError from a failed response.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+
+	var errorResp ErrorResponse
+	if err := json.Unmarshal(body, &errorResp); err == nil {
+		apiErr.Errors, apiErr.FieldErrors = parseErrors(errorResp.Errors)
+
+		switch {
+		case errorResp.Error != "":
+			apiErr.Message = errorResp.Error
+		case errorResp.Message != "":
+			apiErr.Message = errorResp.Message
+		default:
+			apiErr.Message = apiErr.joinErrors()
+		}
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(statusCode)
+	}
+
+	return apiErr
+}
+
+// parseErrors normalises the different shapes of the "errors" field returned
+// by Mailtrap into general messages and per-field messages.
+func parseErrors(raw interface{}) ([]string, map[string][]string) {
+	switch v := raw.(type) {
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return []string{v}, nil
+	case []interface{}:
+		return flattenMessages(v), nil
+	case map[string]interface{}:
+		fields := make(map[string][]string, len(v))
+		for field, value := range v {
+			switch fv := value.(type) {
+			case []interface{}:
+				fields[field] = flattenMessages(fv)
+			default:
+				fields[field] = []string{fmt.Sprint(fv)}
+			}
+		}
+		return nil, fields
+	}
+	return nil, nil
+}
+
+func flattenMessages(values []interface{}) []string {
+	messages := make([]string, 0, len(values))
+	for _, value := range values {
+		messages = append(messages, fmt.Sprint(value))
+	}
+	return messages
+}
+
+// joinErrors renders general and field errors as a single message.
+func (e *APIError) joinErrors() string {
+	parts := append([]string{}, e.Errors...)
+
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, strings.Join(e.FieldErrors[field], ", ")))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// hasStatus reports whether err is an APIError with the given status code.
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsNotFound reports whether err is a 404 response from the API.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is a 401 response from the API.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is a 403 response from the API.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited reports whether err is a 429 response from the API.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsValidationError reports whether err is a 422 response from the API.
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name                string
+		statusCode          int
+		body                string
+		expectedMessage     string
+		expectedErrors      []string
+		expectedFieldErrors map[string][]string
+	}{
+		{
+			name:            "error field",
+			statusCode:      http.StatusNotFound,
+			body:            `{"error":"Not Found"}`,
+			expectedMessage: "Not Found",
+		},
+		{
+			name:            "message field",
+			statusCode:      http.StatusBadRequest,
+			body:            `{"message":"Bad request"}`,
+			expectedMessage: "Bad request",
+		},
+		{
+			name:            "errors string",
+			statusCode:      http.StatusUnauthorized,
+			body:            `{"errors":"Unauthorized"}`,
+			expectedMessage: "Unauthorized",
+			expectedErrors:  []string{"Unauthorized"},
+		},
+		{
+			name:            "errors list",
+			statusCode:      http.StatusBadRequest,
+			body:            `{"errors":["first","second"]}`,
+			expectedMessage: "first; second",
+			expectedErrors:  []string{"first", "second"},
+		},
+		{
+			name:            "field errors",
+			statusCode:      http.StatusUnprocessableEntity,
+			body:            `{"errors":{"name":["is too short","is invalid"],"email":"Invalid email address"}}`,
+			expectedMessage: "email: Invalid email address; name: is too short, is invalid",
+			expectedFieldErrors: map[string][]string{
+				"name":  {"is too short", "is invalid"},
+				"email": {"Invalid email address"},
+			},
+		},
+		{
+			name:            "plain text body",
+			statusCode:      http.StatusBadGateway,
+			body:            "upstream unavailable\n",
+			expectedMessage: "upstream unavailable",
+		},
+		{
+			name:            "empty body",
+			statusCode:      http.StatusServiceUnavailable,
+			body:            "",
+			expectedMessage: "Service Unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := newAPIError(tt.statusCode, []byte(tt.body))
+
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("Expected status %d, got %d", tt.statusCode, apiErr.StatusCode)
+			}
+
+			if apiErr.Body != tt.body {
+				t.Errorf("Expected body %q, got %q", tt.body, apiErr.Body)
+			}
+
+			if apiErr.Message != tt.expectedMessage {
+				t.Errorf("Expected message %q, got %q", tt.expectedMessage, apiErr.Message)
+			}
+
+			if !reflect.DeepEqual(apiErr.Errors, tt.expectedErrors) {
+				t.Errorf("Expected errors %v, got %v", tt.expectedErrors, apiErr.Errors)
+			}
+
+			if !reflect.DeepEqual(apiErr.FieldErrors, tt.expectedFieldErrors) {
+				t.Errorf("Expected field errors %v, got %v", tt.expectedFieldErrors, apiErr.FieldErrors)
+			}
+
+			expectedError := fmt.Sprintf("API error (%d): %s", tt.statusCode, tt.expectedMessage)
+			if apiErr.Error() != expectedError {
+				t.Errorf("Expected error string %q, got %q", expectedError, apiErr.Error())
+			}
+		})
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		check      func(error) bool
+	}{
+		{http.StatusNotFound, IsNotFound},
+		{http.StatusUnauthorized, IsUnauthorized},
+		{http.StatusForbidden, IsForbidden},
+		{http.StatusTooManyRequests, IsRateLimited},
+		{http.StatusUnprocessableEntity, IsValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", newAPIError(tt.statusCode, nil))
+			if !tt.check(err) {
+				t.Errorf("Expected helper to match wrapped %d error", tt.statusCode)
+			}
+
+			if tt.check(newAPIError(http.StatusInternalServerError, nil)) {
+				t.Error("Expected helper not to match a 500 error")
+			}
+
+			if tt.check(errors.New("network error")) {
+				t.Error("Expected helper not to match a non-API error")
+			}
+		})
+	}
+}
+
+func TestGet_ReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.SetBaseURL(server.URL)
+
+	err := client.Get(context.Background(), "/api/accounts/1/inboxes/2", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", apiErr.StatusCode)
+	}
+
+	if !IsNotFound(err) {
+		t.Error("Expected IsNotFound to be true")
+	}
+}
@@ -0,0 +1,7 @@
+package client
+
+// The models in models_gen.go and the endpoint paths in paths_gen.go are
+// generated from the OpenAPI specs in docs/mailtrap-open-api. Edit
+// openapigen.yaml or the schemas it points at, not the generated files.
+
+//go:generate go run ../openapigen -config openapigen.yaml
@@ -0,0 +1,47 @@
+package client
+
+import "context"
+
+// InboxesService handles email testing inboxes.
+type InboxesService service
+
+// List returns every inbox of an account.
+func (s *InboxesService) List(ctx context.Context, accountID int64) ([]Inbox, error) {
+	return ListAll(ctx, func(ctx context.Context, _ Cursor) ([]Inbox, error) {
+		var inboxes []Inbox
+		err := s.client.Get(ctx, getInboxesPath(accountID), &inboxes)
+		return inboxes, err
+	}, SinglePage[Inbox]())
+}
+
+// Get returns a single inbox, including its SMTP credentials.
+func (s *InboxesService) Get(ctx context.Context, accountID, inboxID int64) (*Inbox, error) {
+	var inbox Inbox
+	if err := s.client.Get(ctx, getInboxAttributesPath(accountID, inboxID), &inbox); err != nil {
+		return nil, err
+	}
+	return &inbox, nil
+}
+
+// Create creates an inbox in a project.
+func (s *InboxesService) Create(ctx context.Context, accountID, projectID int64, params InboxParams) (*Inbox, error) {
+	var inbox Inbox
+	if err := s.client.Post(ctx, createInboxPath(accountID, projectID), InboxRequest{Inbox: params}, &inbox); err != nil {
+		return nil, err
+	}
+	return &inbox, nil
+}
+
+// Update changes the name or email username of an inbox.
+func (s *InboxesService) Update(ctx context.Context, accountID, inboxID int64, params InboxParams) (*Inbox, error) {
+	var inbox Inbox
+	if err := s.client.Patch(ctx, updateInboxPath(accountID, inboxID), InboxRequest{Inbox: params}, &inbox); err != nil {
+		return nil, err
+	}
+	return &inbox, nil
+}
+
+// Delete deletes an inbox.
+func (s *InboxesService) Delete(ctx context.Context, accountID, inboxID int64) error {
+	return s.client.Delete(ctx, deleteInboxPath(accountID, inboxID), nil)
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestInboxesService_List(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/inboxes")
+		w.Write([]byte(`[{"id":100,"name":"QA","project_id":10},{"id":101,"name":"Dev","project_id":10}]`))
+	})
+
+	inboxes, err := client.Inboxes.List(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(inboxes) != 2 {
+		t.Errorf("Expected 2 inboxes, got %d", len(inboxes))
+	}
+}
+
+func TestInboxesService_Get(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/inboxes/100")
+		w.Write([]byte(`{"id":100,"name":"QA","username":"user","password":"secret","smtp_ports":[25,465]}`))
+	})
+
+	inbox, err := client.Inboxes.Get(context.Background(), 1, 100)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if inbox.Password != "secret" || len(inbox.SMTPPorts) != 2 {
+		t.Errorf("Expected credentials and SMTP ports, got %+v", inbox)
+	}
+}
+
+func TestInboxesService_Create(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodPost, "/api/accounts/1/projects/10/inboxes")
+
+		body := decodeRequestBody(t, r)
+		inbox, _ := body["inbox"].(map[string]interface{})
+		if inbox["name"] != "QA" {
+			t.Errorf("Expected inbox name QA, got %v", body)
+		}
+		if _, ok := inbox["email_username"]; ok {
+			t.Errorf("Expected email_username to be omitted, got %v", body)
+		}
+
+		w.Write([]byte(`{"id":100,"name":"QA","project_id":10}`))
+	})
+
+	inbox, err := client.Inboxes.Create(context.Background(), 1, 10, InboxParams{Name: "QA"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if inbox.ID != 100 || inbox.ProjectID != 10 {
+		t.Errorf("Expected inbox 100 in project 10, got %+v", inbox)
+	}
+}
+
+func TestInboxesService_Update(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodPatch, "/api/accounts/1/inboxes/100")
+
+		body := decodeRequestBody(t, r)
+		inbox, _ := body["inbox"].(map[string]interface{})
+		if inbox["email_username"] != "qa-team" {
+			t.Errorf("Expected email_username qa-team, got %v", body)
+		}
+
+		w.Write([]byte(`{"id":100,"name":"QA","email_username":"qa-team"}`))
+	})
+
+	inbox, err := client.Inboxes.Update(context.Background(), 1, 100, InboxParams{Name: "QA", EmailUsername: "qa-team"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if inbox.EmailUsername != "qa-team" {
+		t.Errorf("Expected email username qa-team, got %s", inbox.EmailUsername)
+	}
+}
+
+func TestInboxesService_Delete(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodDelete, "/api/accounts/1/inboxes/100")
+		w.Write([]byte(`{"id":100}`))
+	})
+
+	if err := client.Inboxes.Delete(context.Background(), 1, 100); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
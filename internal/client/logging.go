@@ -0,0 +1,163 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem used for client logging. Its level can
+// be set independently with TF_LOG_PROVIDER_MAILTRAP_CLIENT.
+const logSubsystem = "mailtrap_client"
+
+// redactedValue replaces secrets in logged headers and bodies.
+const redactedValue = "***"
+
+// sensitiveHeaders lists request headers whose values are never logged.
+var sensitiveHeaders = map[string]bool{
+	"Api-Token":     true,
+	"Authorization": true,
+}
+
+// sensitiveFields lists JSON keys whose values are redacted from logged
+// request and response bodies, at any nesting level, and scrubbed from
+// recorded cassettes. Inbox SMTP usernames are credentials too.
+var sensitiveFields = map[string]bool{
+	"password":  true,
+	"username":  true,
+	"api_token": true,
+	"token":     true,
+}
+
+// newLogContext returns a context carrying the mailtrap_client subsystem
+// logger. The API token is masked from every field it logs as a safety net
+// on top of header and body redaction.
+func (c *Client) newLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_MAILTRAP", "client"))
+	if c.apiToken != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, c.apiToken)
+	}
+	return ctx
+}
+
+// logRequest logs an outgoing request. Headers are logged at debug level and
+// the body, which can be large, at trace level.
+func logRequest(ctx context.Context, req *http.Request, body []byte, attempt int) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"attempt": attempt + 1,
+		"headers": redactHeaders(req.Header),
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Sending Mailtrap API request", fields)
+
+	if len(body) > 0 {
+		tflog.SubsystemTrace(ctx, logSubsystem, "Mailtrap API request body", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"body":   redactBody(body),
+		})
+	}
+}
+
+// logResponse logs a response and its body. The body is read and replaced
+// with an in-memory copy so callers can still consume it.
+func logResponse(ctx context.Context, req *http.Request, resp *http.Response, latency time.Duration) {
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"status":     resp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+	}
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received Mailtrap API response", fields)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Unable to read Mailtrap API response body for logging", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if len(body) > 0 {
+		tflog.SubsystemTrace(ctx, logSubsystem, "Mailtrap API response body", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"status": resp.StatusCode,
+			"body":   redactBody(body),
+		})
+	}
+}
+
+// logRequestError logs a request that failed before a response was received.
+func logRequestError(ctx context.Context, req *http.Request, err error, latency time.Duration) {
+	tflog.SubsystemDebug(ctx, logSubsystem, "Mailtrap API request failed", map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"latency_ms": latency.Milliseconds(),
+		"error":      err.Error(),
+	})
+}
+
+// redactHeaders flattens headers for logging, masking sensitive values.
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = redactedValue
+			continue
+		}
+		redacted[key] = header.Get(key)
+	}
+	return redacted
+}
+
+// redactBody returns body with sensitive JSON fields masked. Bodies that are
+// not JSON are returned unchanged.
+func redactBody(body []byte) string {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// redactValue walks decoded JSON and masks the values of sensitive keys.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if sensitiveFields[key] {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Api-Token", "secret-token")
+	header.Set("Content-Type", "application/json")
+
+	redacted := redactHeaders(header)
+
+	if redacted["Api-Token"] != redactedValue {
+		t.Errorf("Expected Api-Token to be masked, got %s", redacted["Api-Token"])
+	}
+
+	if redacted["Content-Type"] != "application/json" {
+		t.Errorf("Expected Content-Type application/json, got %s", redacted["Content-Type"])
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "top level password",
+			body:     `{"id":1,"password":"smtp-secret"}`,
+			expected: `{"id":1,"password":"***"}`,
+		},
+		{
+			name:     "nested in list",
+			body:     `[{"inboxes":[{"username":"user","password":"smtp-secret"}]}]`,
+			expected: `[{"inboxes":[{"password":"***","username":"***"}]}]`,
+		},
+		{
+			name:     "api token",
+			body:     `{"api_token":"abc","name":"test"}`,
+			expected: `{"api_token":"***","name":"test"}`,
+		},
+		{
+			name:     "not json",
+			body:     "upstream unavailable",
+			expected: "upstream unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDoRequest_LogsRedactedRequestAndResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":123,"name":"Test Inbox","username":"smtp-user","password":"smtp-secret"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	t.Setenv("TF_LOG_PROVIDER_MAILTRAP_CLIENT", "TRACE")
+
+	client := NewClient("secret-token")
+	client.SetBaseURL(server.URL)
+	client.SetRateLimit(0, 0)
+
+	var inbox Inbox
+	if err := client.Post(ctx, "/api/accounts/1/projects/2/inboxes", map[string]string{"name": "Test Inbox"}, &inbox); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// The response body must still reach the caller after being logged.
+	if inbox.Password != "smtp-secret" {
+		t.Errorf("Expected password to be decoded, got %s", inbox.Password)
+	}
+
+	logged := output.String()
+
+	if strings.Contains(logged, "secret-token") {
+		t.Error("Expected API token to be masked in logs")
+	}
+	if strings.Contains(logged, "smtp-secret") {
+		t.Error("Expected inbox password to be redacted in logs")
+	}
+	if strings.Contains(logged, "smtp-user") {
+		t.Error("Expected inbox SMTP username to be redacted in logs")
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Failed to decode log output: %v", err)
+	}
+
+	messages := map[string]map[string]interface{}{}
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+logSubsystem {
+			t.Errorf("Expected log entry in the %s subsystem, got %v", logSubsystem, entry["@module"])
+		}
+		messages[entry["@message"].(string)] = entry
+	}
+
+	response, ok := messages["Received Mailtrap API response"]
+	if !ok {
+		t.Fatalf("Expected response log entry, got %v", entries)
+	}
+	if response["status"] != float64(http.StatusCreated) {
+		t.Errorf("Expected logged status 201, got %v", response["status"])
+	}
+	if _, ok := response["latency_ms"]; !ok {
+		t.Error("Expected latency to be logged")
+	}
+
+	request, ok := messages["Sending Mailtrap API request"]
+	if !ok {
+		t.Fatalf("Expected request log entry, got %v", entries)
+	}
+	if request["method"] != http.MethodPost {
+		t.Errorf("Expected logged method POST, got %v", request["method"])
+	}
+
+	body, ok := messages["Mailtrap API response body"]
+	if !ok {
+		t.Fatalf("Expected response body log entry, got %v", entries)
+	}
+	if !strings.Contains(body["body"].(string), `"password":"***"`) {
+		t.Errorf("Expected redacted password in logged body, got %v", body["body"])
+	}
+	if !strings.Contains(body["body"].(string), `"username":"***"`) {
+		t.Errorf("Expected redacted username in logged body, got %v", body["body"])
+	}
+}
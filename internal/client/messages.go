@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// messagesPageSize is the number of messages Mailtrap returns per page.
+const messagesPageSize = 30
+
+// MessagesService handles messages captured in email testing inboxes.
+type MessagesService service
+
+// MessageListOptions filters and pages a message list. LastID overrides Page
+// when both are set.
+type MessageListOptions struct {
+	Search string
+	Page   int
+	LastID int64
+}
+
+// List returns one page of up to 30 messages of an inbox, newest first.
+func (s *MessagesService) List(ctx context.Context, accountID, inboxID int64, opts MessageListOptions) ([]Message, error) {
+	query := url.Values{}
+	if opts.Search != "" {
+		query.Set("search", opts.Search)
+	}
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.LastID > 0 {
+		query.Set("last_id", strconv.FormatInt(opts.LastID, 10))
+	}
+
+	endpoint := withQuery(getInboxEmailMessagePath(accountID, inboxID), query)
+
+	var messages []Message
+	if err := s.client.Get(ctx, endpoint, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// ListAll returns every message of an inbox matching search, following the
+// last_id cursor.
+func (s *MessagesService) ListAll(ctx context.Context, accountID, inboxID int64, search string) ([]Message, error) {
+	return ListAll(ctx, func(ctx context.Context, cursor Cursor) ([]Message, error) {
+		return s.List(ctx, accountID, inboxID, MessageListOptions{Search: search, LastID: cursor.LastID})
+	}, ByLastID(messagesPageSize, func(m Message) int64 { return int64(m.ID) }))
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestMessagesService_List(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/inboxes/100/messages")
+
+		query := r.URL.Query()
+		if query.Get("search") != "welcome" {
+			t.Errorf("Expected search welcome, got %s", query.Get("search"))
+		}
+		if query.Get("page") != "2" {
+			t.Errorf("Expected page 2, got %s", query.Get("page"))
+		}
+
+		w.Write([]byte(`[{"id":92,"inbox_id":100,"subject":"Welcome","sent_at":"2022-08-19T11:34:33.839Z","smtp_information":{"ok":true,"data":{"client_ip":"75.180.183.201"}}}]`))
+	})
+
+	messages, err := client.Messages.List(context.Background(), 1, 100, MessageListOptions{Search: "welcome", Page: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(messages))
+	}
+
+	if messages[0].Subject != "Welcome" || messages[0].SMTPInformation.Data.ClientIP != "75.180.183.201" {
+		t.Errorf("Expected decoded message, got %+v", messages[0])
+	}
+}
+
+func TestMessagesService_ListAll(t *testing.T) {
+	const total = 65
+
+	var lastIDs []string
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		lastID := r.URL.Query().Get("last_id")
+		lastIDs = append(lastIDs, lastID)
+
+		// Messages are returned newest first, below last_id when it is set.
+		start := total
+		if lastID != "" {
+			id, _ := strconv.Atoi(lastID)
+			start = id - 1
+		}
+
+		messages := []Message{}
+		for id := start; id >= 1 && len(messages) < messagesPageSize; id-- {
+			messages = append(messages, Message{ID: id, InboxID: 100})
+		}
+		json.NewEncoder(w).Encode(messages)
+	})
+
+	messages, err := client.Messages.ListAll(context.Background(), 1, 100, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(messages) != total {
+		t.Errorf("Expected %d messages, got %d", total, len(messages))
+	}
+
+	expected := []string{"", "36", "6"}
+	if len(lastIDs) != len(expected) {
+		t.Fatalf("Expected last_id sequence %v, got %v", expected, lastIDs)
+	}
+	for i := range expected {
+		if lastIDs[i] != expected[i] {
+			t.Errorf("Expected last_id %q on request %d, got %q", expected[i], i+1, lastIDs[i])
+		}
+	}
+}
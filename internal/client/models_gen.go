@@ -0,0 +1,168 @@
+// Code generated by openapigen from docs/mailtrap-open-api. DO NOT EDIT.
+
+package client
+
+import "time"
+
+// Account represents a Mailtrap account
+type Account struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	AccessLevels []int  `json:"access_levels"`
+}
+
+// Project represents a Mailtrap project
+type Project struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	ShareLinks  ShareLink   `json:"share_links"`
+	Permissions Permissions `json:"permissions"`
+	Inboxes     []Inbox     `json:"inboxes"`
+}
+
+// ProjectRequest represents a request to create/update a project
+type ProjectRequest struct {
+	Project ProjectParams `json:"project"`
+}
+
+// ProjectParams holds the writable attributes of a project
+type ProjectParams struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ShareLink represents share links for a project
+type ShareLink struct {
+	Admin  string `json:"admin"`
+	Viewer string `json:"viewer"`
+}
+
+// Permissions describes what the API token may do with a project or inbox
+type Permissions struct {
+	CanRead    bool `json:"can_read"`
+	CanUpdate  bool `json:"can_update"`
+	CanDestroy bool `json:"can_destroy"`
+	CanLeave   bool `json:"can_leave"`
+}
+
+// Inbox represents a Mailtrap inbox
+type Inbox struct {
+	ID                      int         `json:"id"`
+	Name                    string      `json:"name"`
+	Username                string      `json:"username"`
+	Password                string      `json:"password"`
+	MaxSize                 int         `json:"max_size"`
+	Status                  string      `json:"status"`
+	EmailUsername           string      `json:"email_username"`
+	EmailUsernameEnabled    bool        `json:"email_username_enabled"`
+	SentMessagesCount       int         `json:"sent_messages_count"`
+	ForwardedMessagesCount  int         `json:"forwarded_messages_count"`
+	Used                    bool        `json:"used"`
+	ForwardFromEmailAddress string      `json:"forward_from_email_address"`
+	ProjectID               int         `json:"project_id"`
+	Domain                  string      `json:"domain"`
+	POP3Domain              string      `json:"pop3_domain"`
+	EmailDomain             string      `json:"email_domain"`
+	SMTPPorts               []int       `json:"smtp_ports"`
+	POP3Ports               []int       `json:"pop3_ports"`
+	Permissions             Permissions `json:"permissions"`
+}
+
+// InboxRequest represents a request to create/update an inbox
+type InboxRequest struct {
+	Inbox InboxParams `json:"inbox"`
+}
+
+// InboxParams holds the writable attributes of an inbox
+type InboxParams struct {
+	Name          string `json:"name,omitempty"`
+	EmailUsername string `json:"email_username,omitempty"`
+}
+
+// SendingDomain represents a Mailtrap sending domain
+type SendingDomain struct {
+	ID               int        `json:"id"`
+	Name             string     `json:"name"`
+	CNAME            string     `json:"cname"`
+	Status           string     `json:"status"`
+	ComplianceStatus string     `json:"compliance_status"`
+	DNSRecords       DNSRecords `json:"dns_records"`
+	DNSStatus        DNSStatus  `json:"dns_status"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// SendingDomainRequest represents a request to create a sending domain
+type SendingDomainRequest struct {
+	SendingDomain SendingDomainParams `json:"sending_domain"`
+}
+
+// SendingDomainParams holds the writable attributes of a sending domain
+type SendingDomainParams struct {
+	DomainName string `json:"domain_name,omitempty"`
+}
+
+// SendingDomainList represents the wrapped list of sending domains
+type SendingDomainList struct {
+	Data []SendingDomain `json:"data"`
+}
+
+// DNSRecords contains all DNS records for domain verification
+type DNSRecords struct {
+	CNAME []DNSRecord `json:"cname"`
+	MX    []DNSRecord `json:"mx"`
+	TXT   []DNSRecord `json:"txt"`
+}
+
+// DNSRecord represents a single DNS record
+type DNSRecord struct {
+	Priority   *int   `json:"priority,omitempty"`
+	RecordType string `json:"record_type"`
+	Hostname   string `json:"hostname"`
+	Value      string `json:"value"`
+	Status     string `json:"status"`
+}
+
+// DNSStatus represents the verification status of DNS records
+type DNSStatus struct {
+	CNAME bool `json:"cname"`
+	MX    bool `json:"mx"`
+	TXT   bool `json:"txt"`
+}
+
+// Message represents an email captured in an inbox
+type Message struct {
+	ID                   int             `json:"id"`
+	InboxID              int             `json:"inbox_id"`
+	Subject              string          `json:"subject"`
+	SentAt               time.Time       `json:"sent_at"`
+	FromEmail            string          `json:"from_email"`
+	FromName             string          `json:"from_name"`
+	ToEmail              string          `json:"to_email"`
+	ToName               string          `json:"to_name"`
+	EmailSize            int             `json:"email_size"`
+	IsRead               bool            `json:"is_read"`
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+	HTMLBodySize         int             `json:"html_body_size"`
+	TextBodySize         int             `json:"text_body_size"`
+	HumanSize            string          `json:"human_size"`
+	HTMLPath             string          `json:"html_path"`
+	TxtPath              string          `json:"txt_path"`
+	RawPath              string          `json:"raw_path"`
+	DownloadPath         string          `json:"download_path"`
+	HTMLSourcePath       string          `json:"html_source_path"`
+	BlacklistsReportInfo bool            `json:"blacklists_report_info"`
+	SMTPInformation      SMTPInformation `json:"smtp_information"`
+}
+
+// SMTPInformation describes the SMTP session a message was received in
+type SMTPInformation struct {
+	OK   bool                `json:"ok"`
+	Data SMTPInformationData `json:"data"`
+}
+
+// SMTPInformationData holds the sender address and client IP of an SMTP session
+type SMTPInformationData struct {
+	MailFromAddr string `json:"mail_from_addr"`
+	ClientIP     string `json:"client_ip"`
+}
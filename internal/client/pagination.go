@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Cursor identifies a page of a list endpoint. Page-numbered endpoints use
+// Page, starting at 1. Cursor-based endpoints use LastID, the ID of the last
+// item of the previous page, which is zero for the first page.
+type Cursor struct {
+	Page   int
+	LastID int64
+}
+
+// PageFunc fetches the page of a list at cursor.
+type PageFunc[T any] func(ctx context.Context, cursor Cursor) ([]T, error)
+
+// NextFunc returns the cursor of the page that follows items, or false once
+// the list is exhausted.
+type NextFunc[T any] func(cursor Cursor, items []T) (Cursor, bool)
+
+// maxPages bounds pagination so an endpoint that ignores paging parameters
+// cannot loop forever.
+const maxPages = 10000
+
+// SinglePage is used for list endpoints that return every item in one
+// response.
+func SinglePage[T any]() NextFunc[T] {
+	return func(Cursor, []T) (Cursor, bool) {
+		return Cursor{}, false
+	}
+}
+
+// ByPageNumber follows page numbers until a page holds fewer than pageSize
+// items.
+func ByPageNumber[T any](pageSize int) NextFunc[T] {
+	return func(cursor Cursor, items []T) (Cursor, bool) {
+		if len(items) < pageSize {
+			return Cursor{}, false
+		}
+		page := cursor.Page
+		if page < 1 {
+			page = 1
+		}
+		return Cursor{Page: page + 1}, true
+	}
+}
+
+// ByLastID follows the last_id cursor until a page holds fewer than pageSize
+// items. id returns the ID of an item.
+func ByLastID[T any](pageSize int, id func(T) int64) NextFunc[T] {
+	return func(cursor Cursor, items []T) (Cursor, bool) {
+		if len(items) == 0 || len(items) < pageSize {
+			return Cursor{}, false
+		}
+		lastID := id(items[len(items)-1])
+		if lastID == cursor.LastID {
+			return Cursor{}, false
+		}
+		return Cursor{LastID: lastID}, true
+	}
+}
+
+// Each fetches pages until next reports the list is exhausted and calls fn
+// for every item in order. It stops at the first error returned by a fetch or
+// by fn, and when ctx is cancelled.
+func Each[T any](ctx context.Context, fetch PageFunc[T], next NextFunc[T], fn func(T) error) error {
+	cursor := Cursor{}
+	for pages := 0; ; pages++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if pages >= maxPages {
+			return fmt.Errorf("pagination did not finish after %d pages", maxPages)
+		}
+
+		items, err := fetch(ctx, cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+
+		var more bool
+		cursor, more = next(cursor, items)
+		if !more {
+			return nil
+		}
+	}
+}
+
+// ListAll returns every item of a paginated list.
+func ListAll[T any](ctx context.Context, fetch PageFunc[T], next NextFunc[T]) ([]T, error) {
+	var all []T
+	err := Each(ctx, fetch, next, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// pagedInts returns a PageFunc serving 1..total in pages of pageSize,
+// addressed by page number, and records the cursors it was called with.
+func pagedInts(total, pageSize int, cursors *[]Cursor) PageFunc[int] {
+	return func(ctx context.Context, cursor Cursor) ([]int, error) {
+		*cursors = append(*cursors, cursor)
+
+		page := cursor.Page
+		if page < 1 {
+			page = 1
+		}
+
+		var items []int
+		for i := (page-1)*pageSize + 1; i <= total && len(items) < pageSize; i++ {
+			items = append(items, i)
+		}
+		return items, nil
+	}
+}
+
+func TestListAll_SinglePage(t *testing.T) {
+	var cursors []Cursor
+	items, err := ListAll(context.Background(), pagedInts(5, 100, &cursors), SinglePage[int]())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items) != 5 {
+		t.Errorf("Expected 5 items, got %d", len(items))
+	}
+	if len(cursors) != 1 {
+		t.Errorf("Expected 1 fetch, got %d", len(cursors))
+	}
+}
+
+func TestListAll_ByPageNumber(t *testing.T) {
+	tests := []struct {
+		total         int
+		expectedPages int
+	}{
+		{0, 1},
+		{3, 1},
+		{10, 3}, // the final empty page confirms the list is exhausted
+		{11, 3},
+	}
+
+	for _, tt := range tests {
+		var cursors []Cursor
+		items, err := ListAll(context.Background(), pagedInts(tt.total, 5, &cursors), ByPageNumber[int](5))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(items) != tt.total {
+			t.Errorf("Expected %d items, got %d", tt.total, len(items))
+		}
+
+		if tt.total > 0 && items[len(items)-1] != tt.total {
+			t.Errorf("Expected last item %d, got %d", tt.total, items[len(items)-1])
+		}
+
+		if len(cursors) != tt.expectedPages {
+			t.Errorf("Expected %d fetches for %d items, got %d", tt.expectedPages, tt.total, len(cursors))
+		}
+	}
+}
+
+func TestListAll_ByLastID(t *testing.T) {
+	// Items are served newest first; last_id returns the items below it.
+	fetch := func(ctx context.Context, cursor Cursor) ([]int, error) {
+		start := 7
+		if cursor.LastID > 0 {
+			start = int(cursor.LastID) - 1
+		}
+
+		var items []int
+		for i := start; i >= 1 && len(items) < 3; i-- {
+			items = append(items, i)
+		}
+		return items, nil
+	}
+
+	items, err := ListAll(context.Background(), fetch, ByLastID(3, func(i int) int64 { return int64(i) }))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []int{7, 6, 5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Expected %v, got %v", expected, items)
+	}
+}
+
+func TestListAll_ByLastIDStopsOnRepeatedCursor(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, cursor Cursor) ([]int, error) {
+		calls++
+		return []int{3, 2, 1}, nil
+	}
+
+	if _, err := ListAll(context.Background(), fetch, ByLastID(3, func(i int) int64 { return int64(i) })); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected pagination to stop once the cursor repeats, got %d fetches", calls)
+	}
+}
+
+func TestListAll_FetchError(t *testing.T) {
+	fetchErr := errors.New("boom")
+	fetch := func(ctx context.Context, cursor Cursor) ([]int, error) {
+		if cursor.Page > 1 {
+			return nil, fetchErr
+		}
+		return []int{1, 2}, nil
+	}
+
+	items, err := ListAll(context.Background(), fetch, ByPageNumber[int](2))
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("Expected fetch error, got %v", err)
+	}
+	if items != nil {
+		t.Errorf("Expected no items on error, got %v", items)
+	}
+}
+
+func TestEach_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var cursors []Cursor
+	err := Each(ctx, pagedInts(100, 5, &cursors), ByPageNumber[int](5), func(i int) error {
+		if i == 5 {
+			cancel()
+		}
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if len(cursors) != 1 {
+		t.Errorf("Expected no fetch after cancellation, got %d fetches", len(cursors))
+	}
+}
+
+func TestEach_StopsOnCallbackError(t *testing.T) {
+	stop := errors.New("stop")
+
+	var seen []int
+	var cursors []Cursor
+	err := Each(context.Background(), pagedInts(20, 5, &cursors), ByPageNumber[int](5), func(i int) error {
+		seen = append(seen, i)
+		if i == 7 {
+			return stop
+		}
+		return nil
+	})
+
+	if !errors.Is(err, stop) {
+		t.Errorf("Expected callback error, got %v", err)
+	}
+	if len(seen) != 7 {
+		t.Errorf("Expected 7 items before stopping, got %d", len(seen))
+	}
+}
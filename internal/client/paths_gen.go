@@ -0,0 +1,225 @@
+// Code generated by openapigen from docs/mailtrap-open-api. DO NOT EDIT.
+
+package client
+
+import "fmt"
+
+// getAllAccountsPath returns the path of GET /api/accounts.
+func getAllAccountsPath() string {
+	return "/api/accounts"
+}
+
+// getAllUsersInAccountPath returns the path of GET /api/accounts/{account_id}/account_accesses.
+func getAllUsersInAccountPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/account_accesses", accountID)
+}
+
+// deleteAccountAccessByIdPath returns the path of DELETE /api/accounts/{account_id}/account_accesses/{account_access_id}.
+func deleteAccountAccessByIdPath(accountID, accountAccessID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/account_accesses/%d", accountID, accountAccessID)
+}
+
+// updateUserPermissionsPath returns the path of PUT /api/accounts/{account_id}/account_accesses/{account_access_id}/permissions/bulk.
+func updateUserPermissionsPath(accountID, accountAccessID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/account_accesses/%d/permissions/bulk", accountID, accountAccessID)
+}
+
+// getResourcesPath returns the path of GET /api/accounts/{account_id}/permissions/resources.
+func getResourcesPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/permissions/resources", accountID)
+}
+
+// getAccountBillingUsagePath returns the path of GET /api/accounts/{account_id}/billing/usage.
+func getAccountBillingUsagePath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/billing/usage", accountID)
+}
+
+// createProjectPath returns the path of POST /api/accounts/{account_id}/projects.
+func createProjectPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/projects", accountID)
+}
+
+// getProjectsPath returns the path of GET /api/accounts/{account_id}/projects.
+func getProjectsPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/projects", accountID)
+}
+
+// getProjectPath returns the path of GET /api/accounts/{account_id}/projects/{project_id}.
+func getProjectPath(accountID, projectID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/projects/%d", accountID, projectID)
+}
+
+// updateProjectPath returns the path of PATCH /api/accounts/{account_id}/projects/{project_id}.
+func updateProjectPath(accountID, projectID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/projects/%d", accountID, projectID)
+}
+
+// deleteProjectPath returns the path of DELETE /api/accounts/{account_id}/projects/{project_id}.
+func deleteProjectPath(accountID, projectID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/projects/%d", accountID, projectID)
+}
+
+// createInboxPath returns the path of POST /api/accounts/{account_id}/projects/{project_id}/inboxes.
+func createInboxPath(accountID, projectID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/projects/%d/inboxes", accountID, projectID)
+}
+
+// getInboxAttributesPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}.
+func getInboxAttributesPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d", accountID, inboxID)
+}
+
+// deleteInboxPath returns the path of DELETE /api/accounts/{account_id}/inboxes/{inbox_id}.
+func deleteInboxPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d", accountID, inboxID)
+}
+
+// updateInboxPath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}.
+func updateInboxPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d", accountID, inboxID)
+}
+
+// cleanInboxPath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}/clean.
+func cleanInboxPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/clean", accountID, inboxID)
+}
+
+// markAsReadInboxPath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}/all_read.
+func markAsReadInboxPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/all_read", accountID, inboxID)
+}
+
+// resetInboxCredentialsPath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}/reset_credentials.
+func resetInboxCredentialsPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/reset_credentials", accountID, inboxID)
+}
+
+// enableInboxEmailAddressesPath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}/toggle_email_username.
+func enableInboxEmailAddressesPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/toggle_email_username", accountID, inboxID)
+}
+
+// resetEmailUserNamePerInboxPath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}/reset_email_username.
+func resetEmailUserNamePerInboxPath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/reset_email_username", accountID, inboxID)
+}
+
+// getInboxesPath returns the path of GET /api/accounts/{account_id}/inboxes.
+func getInboxesPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes", accountID)
+}
+
+// testingSendEmailPath returns the path of POST /api/send/{inbox_id}.
+func testingSendEmailPath(inboxID int64) string {
+	return fmt.Sprintf("/api/send/%d", inboxID)
+}
+
+// testingBatchSendEmailPath returns the path of POST /api/batch/{inbox_id}.
+func testingBatchSendEmailPath(inboxID int64) string {
+	return fmt.Sprintf("/api/batch/%d", inboxID)
+}
+
+// showInboxEmailMessagePath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}.
+func showInboxEmailMessagePath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d", accountID, inboxID, messageID)
+}
+
+// updateInboxEmailMessagePath returns the path of PATCH /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}.
+func updateInboxEmailMessagePath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d", accountID, inboxID, messageID)
+}
+
+// deleteInboxEmailMessagePath returns the path of DELETE /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}.
+func deleteInboxEmailMessagePath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessagePath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages.
+func getInboxEmailMessagePath(accountID, inboxID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages", accountID, inboxID)
+}
+
+// forwardInboxEmailMessagePath returns the path of POST /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/forward.
+func forwardInboxEmailMessagePath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/forward", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageSpamReportPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/spam_report.
+func getInboxEmailMessageSpamReportPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/spam_report", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageHTMLAnalysisPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/analyze.
+func getInboxEmailMessageHTMLAnalysisPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/analyze", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageBodyAsTxtPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/body.txt.
+func getInboxEmailMessageBodyAsTxtPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/body.txt", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageBodyAsRawPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/body.raw.
+func getInboxEmailMessageBodyAsRawPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/body.raw", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageBodyAsHtmlSourcePath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/body.htmlsource.
+func getInboxEmailMessageBodyAsHtmlSourcePath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/body.htmlsource", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageBodyAsHtmlPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/body.html.
+func getInboxEmailMessageBodyAsHtmlPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/body.html", accountID, inboxID, messageID)
+}
+
+// getInboxEmailMessageBodyAsEmlPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/body.eml.
+func getInboxEmailMessageBodyAsEmlPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/body.eml", accountID, inboxID, messageID)
+}
+
+// getMailHeadersOfEmailMessagePath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/mail_headers.
+func getMailHeadersOfEmailMessagePath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/mail_headers", accountID, inboxID, messageID)
+}
+
+// getInboxMessageAttachmentsPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/attachments.
+func getInboxMessageAttachmentsPath(accountID, inboxID, messageID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/attachments", accountID, inboxID, messageID)
+}
+
+// getInboxMessageAttachmentPath returns the path of GET /api/accounts/{account_id}/inboxes/{inbox_id}/messages/{message_id}/attachments/{attachment_id}.
+func getInboxMessageAttachmentPath(accountID, inboxID, messageID, attachmentID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/inboxes/%d/messages/%d/attachments/%d", accountID, inboxID, messageID, attachmentID)
+}
+
+// sendingSendEmailPath returns the path of POST /api/send.
+func sendingSendEmailPath() string {
+	return "/api/send"
+}
+
+// sendingBatchSendEmailPath returns the path of POST /api/batch.
+func sendingBatchSendEmailPath() string {
+	return "/api/batch"
+}
+
+// createSendingDomainPath returns the path of POST /api/accounts/{account_id}/sending_domains.
+func createSendingDomainPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/sending_domains", accountID)
+}
+
+// getSendingDomainsPath returns the path of GET /api/accounts/{account_id}/sending_domains.
+func getSendingDomainsPath(accountID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/sending_domains", accountID)
+}
+
+// getSendingDomainPath returns the path of GET /api/accounts/{account_id}/sending_domains/{sending_domain_id}.
+func getSendingDomainPath(accountID, sendingDomainID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/sending_domains/%d", accountID, sendingDomainID)
+}
+
+// sendSendingDomainSetupInstructionsPath returns the path of POST /api/accounts/{account_id}/sending_domains/{sending_domain_id}/send_setup_instructions.
+func sendSendingDomainSetupInstructionsPath(accountID, sendingDomainID int64) string {
+	return fmt.Sprintf("/api/accounts/%d/sending_domains/%d/send_setup_instructions", accountID, sendingDomainID)
+}
@@ -0,0 +1,54 @@
+package client
+
+import "context"
+
+// ProjectsService handles email testing projects.
+type ProjectsService service
+
+// List returns the projects of an account, including their inboxes. The
+// endpoint is not paginated.
+func (s *ProjectsService) List(ctx context.Context, accountID int64) ([]Project, error) {
+	return ListAll(ctx, func(ctx context.Context, _ Cursor) ([]Project, error) {
+		var projects []Project
+		err := s.client.Get(ctx, getProjectsPath(accountID), &projects)
+		return projects, err
+	}, SinglePage[Project]())
+}
+
+// Get returns a single project.
+func (s *ProjectsService) Get(ctx context.Context, accountID, projectID int64) (*Project, error) {
+	var project Project
+	if err := s.client.Get(ctx, getProjectPath(accountID, projectID), &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// Create creates a project with the given name.
+func (s *ProjectsService) Create(ctx context.Context, accountID int64, name string) (*Project, error) {
+	var project Project
+	if err := s.client.Post(ctx, createProjectPath(accountID), newProjectRequest(name), &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// Update renames a project.
+func (s *ProjectsService) Update(ctx context.Context, accountID, projectID int64, name string) (*Project, error) {
+	var project Project
+	if err := s.client.Patch(ctx, updateProjectPath(accountID, projectID), newProjectRequest(name), &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// Delete deletes a project and its inboxes.
+func (s *ProjectsService) Delete(ctx context.Context, accountID, projectID int64) error {
+	return s.client.Delete(ctx, deleteProjectPath(accountID, projectID), nil)
+}
+
+func newProjectRequest(name string) ProjectRequest {
+	var req ProjectRequest
+	req.Project.Name = name
+	return req
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestProjectsService_List(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/projects")
+		w.Write([]byte(`[{"id":10,"name":"Staging","inboxes":[{"id":100,"name":"QA"}]}]`))
+	})
+
+	projects, err := client.Projects.List(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(projects) != 1 || len(projects[0].Inboxes) != 1 {
+		t.Fatalf("Expected 1 project with 1 inbox, got %+v", projects)
+	}
+}
+
+func TestProjectsService_Get(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/projects/10")
+		w.Write([]byte(`{"id":10,"name":"Staging","share_links":{"admin":"https://admin","viewer":"https://viewer"}}`))
+	})
+
+	project, err := client.Projects.Get(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if project.ID != 10 || project.ShareLinks.Admin != "https://admin" {
+		t.Errorf("Expected project 10 with admin share link, got %+v", project)
+	}
+}
+
+func TestProjectsService_Create(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodPost, "/api/accounts/1/projects")
+
+		body := decodeRequestBody(t, r)
+		project, _ := body["project"].(map[string]interface{})
+		if project["name"] != "Staging" {
+			t.Errorf("Expected project name Staging, got %v", body)
+		}
+
+		w.Write([]byte(`{"id":10,"name":"Staging"}`))
+	})
+
+	project, err := client.Projects.Create(context.Background(), 1, "Staging")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if project.ID != 10 {
+		t.Errorf("Expected project ID 10, got %d", project.ID)
+	}
+}
+
+func TestProjectsService_Update(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodPatch, "/api/accounts/1/projects/10")
+
+		body := decodeRequestBody(t, r)
+		project, _ := body["project"].(map[string]interface{})
+		if project["name"] != "Renamed" {
+			t.Errorf("Expected project name Renamed, got %v", body)
+		}
+
+		w.Write([]byte(`{"id":10,"name":"Renamed"}`))
+	})
+
+	project, err := client.Projects.Update(context.Background(), 1, 10, "Renamed")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if project.Name != "Renamed" {
+		t.Errorf("Expected name Renamed, got %s", project.Name)
+	}
+}
+
+func TestProjectsService_Delete(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodDelete, "/api/accounts/1/projects/10")
+		w.Write([]byte(`{"id":10}`))
+	})
+
+	if err := client.Projects.Delete(context.Background(), 1, 10); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestProjectsService_GetNotFound(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+
+	project, err := client.Projects.Get(context.Background(), 1, 10)
+	if !IsNotFound(err) {
+		t.Fatalf("Expected not found error, got %v", err)
+	}
+
+	if project != nil {
+		t.Errorf("Expected no project, got %+v", project)
+	}
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// Mailtrap allows 150 requests per 10 seconds per API token. The default
+	// limit stays below that so parallel Terraform operations rarely hit 429s.
+	defaultRateLimit      = 10
+	defaultRateLimitBurst = 10
+)
+
+// SetRateLimit configures the token bucket every request goes through.
+// requestsPerSecond is the sustained rate and burst the number of requests
+// that may be sent at once. A requestsPerSecond of zero or less disables
+// client-side rate limiting.
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	if requestsPerSecond <= 0 {
+		c.limiter = nil
+		return
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+// waitForRateLimit blocks until the limiter allows another request or ctx is
+// done. Noticeable waits are logged so throttling is visible with TF_LOG.
+func (c *Client) waitForRateLimit(ctx context.Context, method, endpoint string) error {
+	if c.limiter == nil {
+		return nil
+	}
+
+	start := time.Now()
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	if waited := time.Since(start); waited >= time.Millisecond {
+		tflog.SubsystemDebug(ctx, logSubsystem, "Throttled Mailtrap API request by client-side rate limit", map[string]interface{}{
+			"method":   method,
+			"endpoint": endpoint,
+			"wait":     waited.String(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSetRateLimit(t *testing.T) {
+	client := NewClient("test-token")
+
+	if client.limiter == nil {
+		t.Fatal("Expected rate limiting to be enabled by default")
+	}
+
+	client.SetRateLimit(5, 0)
+	if client.limiter == nil {
+		t.Fatal("Expected rate limiter to be configured")
+	}
+	if client.limiter.Limit() != 5 {
+		t.Errorf("Expected limit 5, got %v", client.limiter.Limit())
+	}
+	if client.limiter.Burst() != 1 {
+		t.Errorf("Expected burst to be raised to 1, got %d", client.limiter.Burst())
+	}
+
+	client.SetRateLimit(0, 10)
+	if client.limiter != nil {
+		t.Error("Expected rate limiting to be disabled")
+	}
+}
+
+func TestRateLimit_ThrottlesRequests(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.SetBaseURL(server.URL)
+	client.SetRateLimit(20, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := client.Get(context.Background(), "/test", nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	// The first request uses the burst token, the other four wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected requests to be throttled, took %s", elapsed)
+	}
+
+	if calls != 5 {
+		t.Errorf("Expected 5 requests, got %d", calls)
+	}
+}
+
+func TestRateLimit_RespectsContext(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.SetBaseURL(server.URL)
+	client.SetRateLimit(0.1, 1)
+
+	if err := client.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := client.Get(ctx, "/test", nil); err == nil {
+		t.Fatal("Expected error when the rate limit wait exceeds the context deadline")
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected the throttled request not to be sent, got %d requests", calls)
+	}
+}
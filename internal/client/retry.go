@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// SetRetryPolicy configures how many times a failed request is retried and
+// the longest the client waits between two attempts. A maxRetries of zero
+// disables retries.
+func (c *Client) SetRetryPolicy(maxRetries int, maxWait time.Duration) {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	c.maxRetries = maxRetries
+	c.retryMaxWait = maxWait
+	if c.retryMinWait > maxWait {
+		c.retryMinWait = maxWait
+	}
+}
+
+// isIdempotent reports whether repeating a request with the given method
+// cannot cause additional side effects on the server.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry decides whether a request should be attempted again.
+//
+// A 429 means Mailtrap rejected the request before processing it, so it is
+// safe to retry for every method. Gateway errors and transport failures may
+// happen after the server has acted on the request, so they are only retried
+// for idempotent methods. POST and PATCH are never replayed in that case to
+// avoid creating duplicate projects or inboxes.
+func shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// retryWait returns how long to wait before the next attempt. A Retry-After
+// header sent by the server takes precedence; otherwise exponential backoff
+// with jitter is used. The result never exceeds the configured maximum wait.
+func (c *Client) retryWait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > c.retryMaxWait {
+				return c.retryMaxWait
+			}
+			return wait
+		}
+	}
+
+	backoff := c.retryMinWait << uint(attempt)
+	if backoff <= 0 || backoff > c.retryMaxWait {
+		backoff = c.retryMaxWait
+	}
+
+	// Equal jitter: wait somewhere between half and the full backoff so
+	// parallel Terraform operations do not retry in lockstep.
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given either as a number of
+// seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
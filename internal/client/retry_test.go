@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(serverURL string, maxRetries int) *Client {
+	client := NewClient("test-token")
+	client.SetBaseURL(serverURL)
+	client.SetRetryPolicy(maxRetries, 50*time.Millisecond)
+	client.retryMinWait = time.Millisecond
+	return client
+}
+
+func TestRetry_RateLimitedThenSuccess(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{"id": "123"})
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, 3)
+
+	var result map[string]string
+	if err := client.Get(context.Background(), "/test", &result); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result["id"] != "123" {
+		t.Errorf("Expected id '123', got %s", result["id"])
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, 2)
+
+	if err := client.Get(context.Background(), "/test", nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestRetry_PostNotRetriedOnGatewayError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, 3)
+
+	if err := client.Post(context.Background(), "/test", map[string]string{"name": "test"}, nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestRetry_PostRetriedOnRateLimit(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["name"] != "test" {
+			t.Errorf("Expected request body to be resent, got %v", body)
+		}
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, 3)
+
+	if err := client.Post(context.Background(), "/test", map[string]string{"name": "test"}, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestRetry_Disabled(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL, 0)
+
+	if err := client.Get(context.Background(), "/test", nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 attempt, got %d", calls)
+	}
+}
+
+func TestRetry_StopsWhenContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.SetBaseURL(server.URL)
+	client.SetRetryPolicy(3, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := client.Get(ctx, "/test", nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected retry wait to be interrupted by the context, took %s", elapsed)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	client := NewClient("test-token")
+	client.SetRetryPolicy(5, 10*time.Second)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := client.retryWait(attempt, nil)
+		if wait <= 0 || wait > 10*time.Second {
+			t.Errorf("Attempt %d: expected wait within (0, 10s], got %s", attempt, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if wait := client.retryWait(0, resp); wait != 3*time.Second {
+		t.Errorf("Expected Retry-After wait of 3s, got %s", wait)
+	}
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := client.retryWait(0, resp); wait != 10*time.Second {
+		t.Errorf("Expected Retry-After to be capped at 10s, got %s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			wait, ok := parseRetryAfter(tt.input)
+			if ok != tt.ok {
+				t.Fatalf("Expected ok=%v, got %v", tt.ok, ok)
+			}
+			if wait != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, wait)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package client
+
+import "context"
+
+// SendingDomainsService handles email sending domains. Mailtrap offers no
+// API to delete a sending domain.
+type SendingDomainsService service
+
+// List returns the sending domains of an account.
+func (s *SendingDomainsService) List(ctx context.Context, accountID int64) ([]SendingDomain, error) {
+	return ListAll(ctx, func(ctx context.Context, _ Cursor) ([]SendingDomain, error) {
+		var list SendingDomainList
+		err := s.client.Get(ctx, getSendingDomainsPath(accountID), &list)
+		return list.Data, err
+	}, SinglePage[SendingDomain]())
+}
+
+// Get returns a single sending domain with its DNS records.
+func (s *SendingDomainsService) Get(ctx context.Context, accountID, domainID int64) (*SendingDomain, error) {
+	var domain SendingDomain
+	if err := s.client.Get(ctx, getSendingDomainPath(accountID, domainID), &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// Create registers a sending domain.
+func (s *SendingDomainsService) Create(ctx context.Context, accountID int64, domainName string) (*SendingDomain, error) {
+	var req SendingDomainRequest
+	req.SendingDomain.DomainName = domainName
+
+	var domain SendingDomain
+	if err := s.client.Post(ctx, createSendingDomainPath(accountID), req, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSendingDomainsService_List(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/sending_domains")
+		w.Write([]byte(`{"data":[{"id":5,"name":"example.com","status":"verified"},{"id":6,"name":"example.org","status":"pending"}]}`))
+	})
+
+	domains, err := client.SendingDomains.List(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(domains) != 2 {
+		t.Fatalf("Expected 2 sending domains, got %d", len(domains))
+	}
+
+	if domains[0].Name != "example.com" {
+		t.Errorf("Expected example.com, got %s", domains[0].Name)
+	}
+}
+
+func TestSendingDomainsService_Get(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodGet, "/api/accounts/1/sending_domains/5")
+		w.Write([]byte(`{"id":5,"name":"example.com","dns_records":{"cname":[{"record_type":"CNAME","hostname":"mt","value":"smtp.mailtrap.live"}]}}`))
+	})
+
+	domain, err := client.SendingDomains.Get(context.Background(), 1, 5)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(domain.DNSRecords.CNAME) != 1 {
+		t.Errorf("Expected 1 CNAME record, got %d", len(domain.DNSRecords.CNAME))
+	}
+}
+
+func TestSendingDomainsService_Create(t *testing.T) {
+	client := newServiceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		expectRequest(t, r, http.MethodPost, "/api/accounts/1/sending_domains")
+
+		body := decodeRequestBody(t, r)
+		domain, _ := body["sending_domain"].(map[string]interface{})
+		if domain["domain_name"] != "example.com" {
+			t.Errorf("Expected domain_name example.com, got %v", body)
+		}
+
+		w.Write([]byte(`{"id":5,"name":"example.com"}`))
+	})
+
+	domain, err := client.SendingDomains.Create(context.Background(), 1, "example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if domain.ID != 5 {
+		t.Errorf("Expected domain ID 5, got %d", domain.ID)
+	}
+}
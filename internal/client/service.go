@@ -0,0 +1,6 @@
+package client
+
+// service holds the client shared by the typed API services.
+type service struct {
+	client *Client
+}
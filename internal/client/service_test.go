@@ -0,0 +1,64 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newServiceTestClient returns a client whose general API is served by
+// handler, with retries and rate limiting disabled.
+func newServiceTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("test-token")
+	client.SetBaseURL(server.URL)
+	client.SetRetryPolicy(0, 0)
+	client.SetRateLimit(0, 0)
+	return client
+}
+
+// expectRequest fails the test unless r has the given method and path.
+func expectRequest(t *testing.T, r *http.Request, method, path string) {
+	t.Helper()
+
+	if r.Method != method {
+		t.Errorf("Expected method %s, got %s", method, r.Method)
+	}
+	if r.URL.Path != path {
+		t.Errorf("Expected path %s, got %s", path, r.URL.Path)
+	}
+}
+
+// decodeRequestBody decodes the JSON request body into a generic map.
+func decodeRequestBody(t *testing.T, r *http.Request) map[string]interface{} {
+	t.Helper()
+
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("Failed to read request body: %v", err)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		t.Fatalf("Failed to decode request body %s: %v", raw, err)
+	}
+	return body
+}
+
+func TestNewClient_Services(t *testing.T) {
+	client := NewClient("test-token")
+
+	if client.Accounts == nil || client.Projects == nil || client.Inboxes == nil || client.SendingDomains == nil {
+		t.Fatal("Expected all services to be initialized")
+	}
+
+	if client.Projects.client != client {
+		t.Error("Expected services to share the client")
+	}
+}
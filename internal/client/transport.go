@@ -0,0 +1,47 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+)
+
+// TransportOptions configures how the client connects to the Mailtrap API,
+// for networks that route traffic through a proxy or intercept TLS.
+type TransportOptions struct {
+	// ProxyURL is the proxy every request is sent through. When nil, the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.
+	ProxyURL *url.URL
+
+	// RootCAs are the certificate authorities trusted to sign server
+	// certificates. When nil, the system pool is used.
+	RootCAs *x509.CertPool
+
+	// Certificates are presented to servers that ask for a client
+	// certificate.
+	Certificates []tls.Certificate
+
+	// InsecureSkipVerify disables server certificate verification.
+	InsecureSkipVerify bool
+}
+
+// NewTransport returns an HTTP transport with the defaults of
+// http.DefaultTransport and the given proxy and TLS settings. Pass it to
+// SetTransport.
+func NewTransport(opts TransportOptions) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(opts.ProxyURL)
+	}
+
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            opts.RootCAs,
+		Certificates:       opts.Certificates,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	return transport
+}
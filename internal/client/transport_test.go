@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestNewTransport_Proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxy receives the absolute URL of the target.
+		proxied = append(proxied, r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":1,"name":"Proxied","access_levels":[1000]}]`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	c := NewClient("test-token")
+	c.SetBaseURL("http://mailtrap.invalid")
+	c.SetRetryPolicy(0, 0)
+	c.SetTransport(NewTransport(TransportOptions{ProxyURL: proxyURL}))
+
+	accounts, err := c.Accounts.List(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].Name != "Proxied" {
+		t.Errorf("Expected the proxied account, got %+v", accounts)
+	}
+	if len(proxied) != 1 || proxied[0] != "http://mailtrap.invalid/api/accounts" {
+		t.Errorf("Expected the request to go through the proxy, got %v", proxied)
+	}
+}
+
+func TestNewTransport_TLSOptions(t *testing.T) {
+	pool := x509.NewCertPool()
+	certs := []tls.Certificate{{}}
+
+	transport := NewTransport(TransportOptions{
+		RootCAs:            pool,
+		Certificates:       certs,
+		InsecureSkipVerify: true,
+	})
+
+	config := transport.TLSClientConfig
+	if config.RootCAs != pool {
+		t.Error("Expected RootCAs to be used")
+	}
+	if len(config.Certificates) != 1 {
+		t.Errorf("Expected 1 client certificate, got %d", len(config.Certificates))
+	}
+	if !config.InsecureSkipVerify {
+		t.Error("Expected InsecureSkipVerify to be set")
+	}
+	if config.MinVersion != tls.VersionTLS12 {
+		t.Errorf("Expected TLS 1.2 as the minimum version, got %x", config.MinVersion)
+	}
+	if transport.Proxy == nil {
+		t.Error("Expected the proxy environment variables to apply without a ProxyURL")
+	}
+}
@@ -0,0 +1,383 @@
+package fakemailtrap
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+var (
+	emailUsernamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	domainNamePattern    = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+)
+
+// route dispatches an authenticated request under /api/accounts.
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	if path != "api/accounts" && !strings.HasPrefix(path, "api/accounts/") {
+		notFound(w)
+		return
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "api/accounts"), "/")[1:]
+	if len(segments) == 0 {
+		s.handleAccounts(w, r)
+		return
+	}
+
+	accountID, err := strconv.Atoi(segments[0])
+	if err != nil {
+		notFound(w)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.accounts[accountID]; !ok {
+		writeJSON(w, http.StatusForbidden, map[string]string{"errors": "Access forbidden"})
+		return
+	}
+
+	rest := segments[1:]
+	if len(rest) == 0 {
+		notFound(w)
+		return
+	}
+
+	var id int
+	if len(rest) > 1 {
+		if id, err = strconv.Atoi(rest[1]); err != nil {
+			notFound(w)
+			return
+		}
+	}
+
+	switch {
+	case rest[0] == "projects" && len(rest) == 1:
+		s.handleProjects(w, r, accountID)
+	case rest[0] == "projects" && len(rest) == 2:
+		s.handleProject(w, r, accountID, id)
+	case rest[0] == "projects" && len(rest) == 3 && rest[2] == "inboxes":
+		s.handleProjectInboxes(w, r, accountID, id)
+	case rest[0] == "inboxes" && len(rest) == 1:
+		s.handleInboxes(w, r, accountID)
+	case rest[0] == "inboxes" && len(rest) == 2:
+		s.handleInbox(w, r, accountID, id)
+	case rest[0] == "inboxes" && len(rest) == 3:
+		s.handleInboxAction(w, r, accountID, id, rest[2])
+	case rest[0] == "sending_domains" && len(rest) == 1:
+		s.handleSendingDomains(w, r, accountID)
+	case rest[0] == "sending_domains" && len(rest) == 2:
+		s.handleSendingDomain(w, r, accountID, id)
+	case rest[0] == "sending_domains" && len(rest) == 3 && rest[2] == "send_setup_instructions":
+		s.handleSendSetupInstructions(w, r, accountID, id)
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) handleAccounts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	accounts := []client.Account{}
+	for _, a := range s.sortedAccounts() {
+		accounts = append(accounts, a.Account)
+	}
+	writeJSON(w, http.StatusOK, accounts)
+}
+
+func (s *Server) handleProjects(w http.ResponseWriter, r *http.Request, accountID int) {
+	switch r.Method {
+	case http.MethodGet:
+		projects := []client.Project{}
+		for _, p := range s.sortedProjects(accountID) {
+			projects = append(projects, s.projectView(p))
+		}
+		writeJSON(w, http.StatusOK, projects)
+	case http.MethodPost:
+		var req client.ProjectRequest
+		if !decodeJSON(w, r, &req) || !validateProjectName(w, req.Project.Name) {
+			return
+		}
+		writeJSON(w, http.StatusOK, s.projectView(s.createProject(accountID, req.Project.Name)))
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleProject(w http.ResponseWriter, r *http.Request, accountID, projectID int) {
+	p, ok := s.projects[projectID]
+	if !ok || p.accountID != accountID {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.projectView(p))
+	case http.MethodPatch:
+		var req client.ProjectRequest
+		if !decodeJSON(w, r, &req) || !validateProjectName(w, req.Project.Name) {
+			return
+		}
+		p.Name = req.Project.Name
+		writeJSON(w, http.StatusOK, s.projectView(p))
+	case http.MethodDelete:
+		s.deleteProject(projectID)
+		writeJSON(w, http.StatusOK, map[string]int{"id": projectID})
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleProjectInboxes(w http.ResponseWriter, r *http.Request, accountID, projectID int) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w)
+		return
+	}
+
+	p, ok := s.projects[projectID]
+	if !ok || p.accountID != accountID {
+		notFound(w)
+		return
+	}
+
+	var req client.InboxRequest
+	if !decodeJSON(w, r, &req) || !s.validateInbox(w, req.Inbox, 0) {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.createInbox(accountID, projectID, req.Inbox.Name, req.Inbox.EmailUsername).Inbox)
+}
+
+func (s *Server) handleInboxes(w http.ResponseWriter, r *http.Request, accountID int) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+
+	inboxes := []client.Inbox{}
+	for _, i := range s.sortedInboxes(accountID) {
+		inboxes = append(inboxes, i.Inbox)
+	}
+	writeJSON(w, http.StatusOK, inboxes)
+}
+
+func (s *Server) handleInbox(w http.ResponseWriter, r *http.Request, accountID, inboxID int) {
+	i, ok := s.inboxes[inboxID]
+	if !ok || i.accountID != accountID {
+		notFound(w)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, i.Inbox)
+	case http.MethodPatch:
+		var req client.InboxRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+		if req.Inbox.Name == "" {
+			req.Inbox.Name = i.Name
+		}
+		if !s.validateInbox(w, req.Inbox, inboxID) {
+			return
+		}
+		i.Name = req.Inbox.Name
+		if req.Inbox.EmailUsername != "" {
+			i.EmailUsername = req.Inbox.EmailUsername
+		}
+		writeJSON(w, http.StatusOK, i.Inbox)
+	case http.MethodDelete:
+		delete(s.inboxes, inboxID)
+		writeJSON(w, http.StatusOK, i.Inbox)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleInboxAction(w http.ResponseWriter, r *http.Request, accountID, inboxID int, action string) {
+	i, ok := s.inboxes[inboxID]
+	if !ok || i.accountID != accountID {
+		notFound(w)
+		return
+	}
+
+	if action == "messages" {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, []client.Message{})
+		return
+	}
+
+	if r.Method != http.MethodPatch {
+		methodNotAllowed(w)
+		return
+	}
+
+	switch action {
+	case "clean":
+		i.SentMessagesCount = 0
+		i.ForwardedMessagesCount = 0
+	case "all_read":
+	case "reset_credentials":
+		i.Password = randomHex(7)
+	case "toggle_email_username":
+		i.EmailUsernameEnabled = !i.EmailUsernameEnabled
+	case "reset_email_username":
+		i.EmailUsername = randomHex(7)
+	default:
+		notFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, i.Inbox)
+}
+
+func (s *Server) handleSendingDomains(w http.ResponseWriter, r *http.Request, accountID int) {
+	switch r.Method {
+	case http.MethodGet:
+		domains := []client.SendingDomain{}
+		for _, d := range s.sortedSendingDomains(accountID) {
+			domains = append(domains, d.SendingDomain)
+		}
+		writeJSON(w, http.StatusOK, client.SendingDomainList{Data: domains})
+	case http.MethodPost:
+		var req client.SendingDomainRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		name := req.SendingDomain.DomainName
+		if !domainNamePattern.MatchString(name) {
+			validationError(w, map[string][]string{"domain_name": {"is invalid"}})
+			return
+		}
+		for _, d := range s.sortedSendingDomains(accountID) {
+			if d.Name == name {
+				validationError(w, map[string][]string{"base": {"Validation failed: Domain name has already been taken"}})
+				return
+			}
+		}
+
+		writeJSON(w, http.StatusOK, s.createSendingDomain(accountID, name).SendingDomain)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) handleSendingDomain(w http.ResponseWriter, r *http.Request, accountID, domainID int) {
+	d, ok := s.domains[domainID]
+	if !ok || d.accountID != accountID {
+		notFound(w)
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, d.SendingDomain)
+}
+
+func (s *Server) handleSendSetupInstructions(w http.ResponseWriter, r *http.Request, accountID, domainID int) {
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w)
+		return
+	}
+
+	d, ok := s.domains[domainID]
+	if !ok || d.accountID != accountID {
+		notFound(w)
+		return
+	}
+
+	var req struct {
+		Email string `json:"email"`
+	}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if !strings.Contains(req.Email, "@") {
+		validationError(w, map[string][]string{"email": {"Invalid email address"}})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateProjectName enforces the 2 to 100 character project name limit.
+func validateProjectName(w http.ResponseWriter, name string) bool {
+	switch n := len(strings.TrimSpace(name)); {
+	case n < 2:
+		validationError(w, map[string][]string{"name": {"is too short (minimum is 2 characters)"}})
+		return false
+	case n > 100:
+		validationError(w, map[string][]string{"name": {"is too long (maximum is 100 characters)"}})
+		return false
+	}
+	return true
+}
+
+// validateInbox checks the name and email username of an inbox. inboxID is
+// the inbox being updated, or zero on create.
+func (s *Server) validateInbox(w http.ResponseWriter, params client.InboxParams, inboxID int) bool {
+	if strings.TrimSpace(params.Name) == "" {
+		validationError(w, map[string][]string{"name": {"can't be blank"}})
+		return false
+	}
+
+	if params.EmailUsername == "" {
+		return true
+	}
+	if !emailUsernamePattern.MatchString(params.EmailUsername) {
+		validationError(w, map[string][]string{"email_username": {"is invalid"}})
+		return false
+	}
+	for id, i := range s.inboxes {
+		if id != inboxID && i.EmailUsername == params.EmailUsername {
+			validationError(w, map[string][]string{"email_username": {"has already been taken"}})
+			return false
+		}
+	}
+	return true
+}
+
+// decodeJSON decodes the request body into v, answering 400 when it is not
+// valid JSON.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "Invalid JSON: " + err.Error()})
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func notFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, map[string]string{"error": "Not Found"})
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "Method Not Allowed"})
+}
+
+func validationError(w http.ResponseWriter, errors map[string][]string) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": errors})
+}
@@ -0,0 +1,287 @@
+package fakemailtrap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+func itoa(i int) string {
+	return strconv.Itoa(i)
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+	accountID := int64(account.ID)
+
+	project, err := c.Projects.Create(ctx, accountID, "Staging")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if project.ShareLinks.Admin == "" || project.ShareLinks.Viewer == "" {
+		t.Errorf("Expected share links, got %+v", project.ShareLinks)
+	}
+
+	project, err = c.Projects.Update(ctx, accountID, int64(project.ID), "Production")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if project.Name != "Production" {
+		t.Errorf("Expected name Production, got %s", project.Name)
+	}
+
+	projects, err := c.Projects.List(ctx, accountID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(projects) != 1 || projects[0].Name != "Production" {
+		t.Errorf("Expected the renamed project, got %+v", projects)
+	}
+
+	if err := c.Projects.Delete(ctx, accountID, int64(project.ID)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := c.Projects.Get(ctx, accountID, int64(project.ID)); !client.IsNotFound(err) {
+		t.Errorf("Expected not found after delete, got %v", err)
+	}
+}
+
+func TestProjectValidation(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+
+	_, err := c.Projects.Create(context.Background(), int64(account.ID), "x")
+	if !client.IsValidationError(err) {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+
+	var apiErr *client.APIError
+	errors.As(err, &apiErr)
+	if len(apiErr.FieldErrors["name"]) != 1 {
+		t.Errorf("Expected a name field error, got %v", apiErr.FieldErrors)
+	}
+}
+
+func TestProject_OtherAccountNotFound(t *testing.T) {
+	s, account := newTestServer(t)
+	other := s.AddAccount("Other")
+	project := s.AddProject(other.ID, "Theirs")
+
+	_, err := newTestClient(t, s).Projects.Get(context.Background(), int64(account.ID), int64(project.ID))
+	if !client.IsNotFound(err) {
+		t.Errorf("Expected not found for a project of another account, got %v", err)
+	}
+}
+
+func TestInboxLifecycle(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+	accountID := int64(account.ID)
+
+	project := s.AddProject(account.ID, "Staging")
+
+	inbox, err := c.Inboxes.Create(ctx, accountID, int64(project.ID), client.InboxParams{Name: "QA"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if inbox.Username == "" || inbox.Password == "" {
+		t.Errorf("Expected generated credentials, got %+v", inbox)
+	}
+	if inbox.ProjectID != project.ID {
+		t.Errorf("Expected project ID %d, got %d", project.ID, inbox.ProjectID)
+	}
+
+	inbox, err = c.Inboxes.Update(ctx, accountID, int64(inbox.ID), client.InboxParams{Name: "QA", EmailUsername: "qa-team"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if inbox.EmailUsername != "qa-team" {
+		t.Errorf("Expected email username qa-team, got %s", inbox.EmailUsername)
+	}
+
+	got, err := c.Projects.Get(ctx, accountID, int64(project.ID))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(got.Inboxes) != 1 || got.Inboxes[0].ID != inbox.ID {
+		t.Errorf("Expected the project to list the inbox, got %+v", got.Inboxes)
+	}
+
+	if err := c.Inboxes.Delete(ctx, accountID, int64(inbox.ID)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := c.Inboxes.Get(ctx, accountID, int64(inbox.ID)); !client.IsNotFound(err) {
+		t.Errorf("Expected not found after delete, got %v", err)
+	}
+}
+
+func TestInboxValidation(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+	accountID := int64(account.ID)
+
+	project := s.AddProject(account.ID, "Staging")
+	s.AddInbox(account.ID, project.ID, "Existing")
+	existing := s.AddInbox(account.ID, project.ID, "Taken")
+
+	tests := []struct {
+		name   string
+		params client.InboxParams
+		field  string
+	}{
+		{"blank name", client.InboxParams{}, "name"},
+		{"invalid email username", client.InboxParams{Name: "QA", EmailUsername: "Not Valid"}, "email_username"},
+		{"taken email username", client.InboxParams{Name: "QA", EmailUsername: existing.EmailUsername}, "email_username"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Inboxes.Create(ctx, accountID, int64(project.ID), tt.params)
+
+			var apiErr *client.APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+				t.Fatalf("Expected 422 error, got %v", err)
+			}
+			if _, ok := apiErr.FieldErrors[tt.field]; !ok {
+				t.Errorf("Expected %s field error, got %v", tt.field, apiErr.FieldErrors)
+			}
+		})
+	}
+}
+
+func TestInbox_MissingProject(t *testing.T) {
+	s, account := newTestServer(t)
+
+	_, err := newTestClient(t, s).Inboxes.Create(context.Background(), int64(account.ID), 42, client.InboxParams{Name: "QA"})
+	if !client.IsNotFound(err) {
+		t.Errorf("Expected not found for a missing project, got %v", err)
+	}
+}
+
+func TestDeleteProject_RemovesInboxes(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+
+	project := s.AddProject(account.ID, "Staging")
+	inbox := s.AddInbox(account.ID, project.ID, "QA")
+
+	if err := c.Projects.Delete(ctx, int64(account.ID), int64(project.ID)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, ok := s.Inbox(inbox.ID); ok {
+		t.Error("Expected the inbox to be deleted with its project")
+	}
+}
+
+func TestInboxActions(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+
+	project := s.AddProject(account.ID, "Staging")
+	inbox := s.AddInbox(account.ID, project.ID, "QA")
+	endpoint := "/api/accounts/" + itoa(account.ID) + "/inboxes/" + itoa(inbox.ID)
+
+	var reset client.Inbox
+	if err := c.Patch(ctx, endpoint+"/reset_credentials", nil, &reset); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if reset.Password == inbox.Password {
+		t.Error("Expected reset_credentials to change the password")
+	}
+
+	var toggled client.Inbox
+	if err := c.Patch(ctx, endpoint+"/toggle_email_username", nil, &toggled); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !toggled.EmailUsernameEnabled {
+		t.Error("Expected toggle_email_username to enable the email username")
+	}
+
+	messages, err := c.Messages.ListAll(ctx, int64(account.ID), int64(inbox.ID), "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(messages) != 0 {
+		t.Errorf("Expected no messages, got %d", len(messages))
+	}
+}
+
+func TestSendingDomainLifecycle(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+	accountID := int64(account.ID)
+
+	domain, err := c.SendingDomains.Create(ctx, accountID, "example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(domain.DNSRecords.CNAME) == 0 || len(domain.DNSRecords.TXT) == 0 {
+		t.Errorf("Expected DNS records, got %+v", domain.DNSRecords)
+	}
+
+	if _, err := c.SendingDomains.Create(ctx, accountID, "example.com"); !client.IsValidationError(err) {
+		t.Errorf("Expected validation error for a duplicate domain, got %v", err)
+	}
+
+	if _, err := c.SendingDomains.Create(ctx, accountID, "not a domain"); !client.IsValidationError(err) {
+		t.Errorf("Expected validation error for an invalid domain, got %v", err)
+	}
+
+	domains, err := c.SendingDomains.List(ctx, accountID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(domains) != 1 || domains[0].ID != domain.ID {
+		t.Errorf("Expected the created domain, got %+v", domains)
+	}
+
+	got, err := c.SendingDomains.Get(ctx, accountID, int64(domain.ID))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.Name != "example.com" {
+		t.Errorf("Expected example.com, got %s", got.Name)
+	}
+
+	if _, err := c.SendingDomains.Get(ctx, accountID, 42); !client.IsNotFound(err) {
+		t.Errorf("Expected not found for a missing domain, got %v", err)
+	}
+}
+
+func TestSendSetupInstructions(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+
+	domain := s.AddSendingDomain(account.ID, "example.com")
+	endpoint := "/api/accounts/" + itoa(account.ID) + "/sending_domains/" + itoa(domain.ID) + "/send_setup_instructions"
+
+	if err := c.Post(ctx, endpoint, map[string]string{"email": "devops@example.com"}, nil); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	if err := c.Post(ctx, endpoint, map[string]string{"email": "invalid"}, nil); !client.IsValidationError(err) {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
+
+func TestUnknownRoute(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	if err := newTestClient(t, s).Get(context.Background(), "/api/unknown", nil); !client.IsNotFound(err) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
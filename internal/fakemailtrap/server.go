@@ -0,0 +1,198 @@
+// Package fakemailtrap provides an in-memory fake of the Mailtrap general
+// API for tests. It keeps accounts, projects, inboxes and sending domains in
+// memory, follows the paths and payloads of docs/mailtrap-open-api, and
+// answers with the same 401, 403, 404 and 422 errors as Mailtrap.
+package fakemailtrap
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultToken is the API token accepted by a new Server.
+const DefaultToken = "fake-mailtrap-token"
+
+// Server is a stateful fake Mailtrap API served over HTTP.
+type Server struct {
+	// URL is the base URL of the fake, for use as the provider base_url.
+	URL string
+
+	httpServer *httptest.Server
+	tls        *tlsCertificates
+
+	mu       sync.Mutex
+	token    string
+	nextID   int
+	now      func() time.Time
+	accounts map[int]*account
+	projects map[int]*project
+	inboxes  map[int]*inbox
+	domains  map[int]*sendingDomain
+
+	latency  time.Duration
+	faults   []*Fault
+	requests []Request
+}
+
+// Request is a request received by the fake, recorded for assertions.
+type Request struct {
+	Method string
+	Path   string
+}
+
+// Fault makes matching requests fail with Status instead of being served.
+// Method and Path, a path prefix, match every request when empty. Count is
+// the number of requests to fail; zero or less fails every match until the
+// fault is cleared.
+type Fault struct {
+	Method     string
+	Path       string
+	Status     int
+	Count      int
+	RetryAfter string
+}
+
+// NewServer starts a fake with no data that accepts DefaultToken. Call Close
+// when done.
+func NewServer() *Server {
+	s := newServer()
+	s.httpServer.Start()
+	s.URL = s.httpServer.URL
+	return s
+}
+
+// newServer returns a fake with no data whose HTTP server is not started.
+func newServer() *Server {
+	s := &Server{
+		token:    DefaultToken,
+		nextID:   1000,
+		now:      time.Now,
+		accounts: make(map[int]*account),
+		projects: make(map[int]*project),
+		inboxes:  make(map[int]*inbox),
+		domains:  make(map[int]*sendingDomain),
+	}
+
+	s.httpServer = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts the fake down.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Token returns the API token the fake accepts.
+func (s *Server) Token() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// SetToken changes the API token the fake accepts.
+func (s *Server) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
+
+// SetLatency delays every response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// AddFault injects a failure, such as a 429 or 5xx, into matching requests.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every injected failure.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns every request received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// serveHTTP records the request, applies latency, faults and authentication,
+// then dispatches it to the route handlers.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path})
+	latency := s.latency
+	fault := s.matchFault(r)
+	token := s.token
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if fault != nil {
+		if fault.RetryAfter != "" {
+			w.Header().Set("Retry-After", fault.RetryAfter)
+		}
+		writeJSON(w, fault.Status, map[string]string{"error": http.StatusText(fault.Status)})
+		return
+	}
+
+	if r.Header.Get("Api-Token") != token && r.Header.Get("Authorization") != "Bearer "+token {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "Incorrect API token"})
+		return
+	}
+
+	s.route(w, r)
+}
+
+// matchFault returns the first fault matching r and consumes one of its
+// counts. It must be called with s.mu held.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" && !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		matched := *f
+		return &matched
+	}
+	return nil
+}
+
+// newID returns the next ID. IDs are unique across every kind of object.
+// It must be called with s.mu held.
+func (s *Server) newID() int {
+	s.nextID++
+	return s.nextID
+}
+
+// String describes the fake for test failure messages.
+func (s *Server) String() string {
+	return fmt.Sprintf("fake Mailtrap API at %s", s.URL)
+}
@@ -0,0 +1,154 @@
+package fakemailtrap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// newTestClient returns a client for s with retries and rate limiting
+// disabled.
+func newTestClient(t *testing.T, s *Server) *client.Client {
+	t.Helper()
+
+	c := client.NewClient(s.Token())
+	c.SetBaseURL(s.URL)
+	c.SetRetryPolicy(0, 0)
+	c.SetRateLimit(0, 0)
+	return c
+}
+
+// newTestServer starts a fake with one account and closes it when the test
+// ends.
+func newTestServer(t *testing.T) (*Server, client.Account) {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+	return s, s.AddAccount("Primary")
+}
+
+func TestServer_RejectsInvalidToken(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	c := client.NewClient("wrong-token")
+	c.SetBaseURL(s.URL)
+	c.SetRetryPolicy(0, 0)
+
+	_, err := c.Accounts.List(context.Background())
+	if !client.IsUnauthorized(err) {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+}
+
+func TestServer_ForbidsUnknownAccount(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	_, err := newTestClient(t, s).Projects.List(context.Background(), 1)
+	if !client.IsForbidden(err) {
+		t.Errorf("Expected forbidden error, got %v", err)
+	}
+}
+
+func TestServer_Fault(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+
+	s.AddFault(Fault{Method: http.MethodGet, Path: "/api/accounts", Status: http.StatusTooManyRequests, Count: 2, RetryAfter: "0"})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Accounts.List(context.Background()); !client.IsRateLimited(err) {
+			t.Fatalf("Expected rate limited error on request %d, got %v", i+1, err)
+		}
+	}
+
+	accounts, err := c.Accounts.List(context.Background())
+	if err != nil {
+		t.Fatalf("Expected fault to be exhausted, got %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].ID != account.ID {
+		t.Errorf("Expected the seeded account, got %+v", accounts)
+	}
+}
+
+func TestServer_PersistentFault(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+
+	s.AddFault(Fault{Path: "/api/accounts/", Status: http.StatusServiceUnavailable})
+
+	for i := 0; i < 3; i++ {
+		_, err := c.Projects.List(context.Background(), int64(account.ID))
+
+		var apiErr *client.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Fatalf("Expected 503 error, got %v", err)
+		}
+	}
+
+	// Paths outside the fault prefix are unaffected.
+	if _, err := c.Accounts.List(context.Background()); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	s.ClearFaults()
+	if _, err := c.Projects.List(context.Background(), int64(account.ID)); err != nil {
+		t.Errorf("Expected no error after clearing faults, got %v", err)
+	}
+}
+
+func TestServer_RetriesAgainstFault(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	c := newTestClient(t, s)
+	c.SetRetryPolicy(2, time.Millisecond)
+
+	s.AddFault(Fault{Status: http.StatusBadGateway, Count: 2})
+
+	if _, err := c.Accounts.List(context.Background()); err != nil {
+		t.Fatalf("Expected the client to retry past the fault, got %v", err)
+	}
+
+	if requests := s.Requests(); len(requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(requests))
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	s, _ := newTestServer(t)
+	s.SetLatency(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := newTestClient(t, s).Accounts.List(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestServer_Requests(t *testing.T) {
+	s, account := newTestServer(t)
+	c := newTestClient(t, s)
+
+	c.Accounts.List(context.Background())
+	c.Projects.Create(context.Background(), int64(account.ID), "Staging")
+
+	requests := s.Requests()
+	expected := []Request{
+		{Method: http.MethodGet, Path: "/api/accounts"},
+		{Method: http.MethodPost, Path: "/api/accounts/" + itoa(account.ID) + "/projects"},
+	}
+
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected %d requests, got %v", len(expected), requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Expected request %v, got %v", expected[i], requests[i])
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package fakemailtrap
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// Access levels reported for accounts.
+const (
+	AccessOwner = 1000
+	AccessAdmin = 100
+)
+
+// ownerPermissions are the permissions reported on every project and inbox.
+// The fake does not model shared resources, so the token always owns them.
+var ownerPermissions = client.Permissions{CanRead: true, CanUpdate: true, CanDestroy: true}
+
+type account struct {
+	client.Account
+}
+
+type project struct {
+	accountID int
+	client.Project
+}
+
+type inbox struct {
+	accountID int
+	client.Inbox
+}
+
+type sendingDomain struct {
+	accountID int
+	client.SendingDomain
+}
+
+// AddAccount adds an account the token can access and returns it. Access
+// levels default to owner.
+func (s *Server) AddAccount(name string, accessLevels ...int) client.Account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(accessLevels) == 0 {
+		accessLevels = []int{AccessOwner}
+	}
+
+	a := &account{client.Account{ID: s.newID(), Name: name, AccessLevels: accessLevels}}
+	s.accounts[a.ID] = a
+	return a.Account
+}
+
+// AddProject adds a project to an account and returns it.
+func (s *Server) AddProject(accountID int, name string) client.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.projectView(s.createProject(accountID, name))
+}
+
+// AddInbox adds an inbox to a project and returns it.
+func (s *Server) AddInbox(accountID, projectID int, name string) client.Inbox {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createInbox(accountID, projectID, name, "").Inbox
+}
+
+// AddSendingDomain adds a sending domain to an account and returns it.
+func (s *Server) AddSendingDomain(accountID int, name string) client.SendingDomain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createSendingDomain(accountID, name).SendingDomain
+}
+
+// Project returns a stored project, including its inboxes.
+func (s *Server) Project(id int) (client.Project, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.projects[id]
+	if !ok {
+		return client.Project{}, false
+	}
+	return s.projectView(p), true
+}
+
+// Inbox returns a stored inbox.
+func (s *Server) Inbox(id int) (client.Inbox, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.inboxes[id]
+	if !ok {
+		return client.Inbox{}, false
+	}
+	return i.Inbox, true
+}
+
+// SendingDomain returns a stored sending domain.
+func (s *Server) SendingDomain(id int) (client.SendingDomain, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.domains[id]
+	if !ok {
+		return client.SendingDomain{}, false
+	}
+	return d.SendingDomain, true
+}
+
+// UpdateProject changes a stored project outside of the API, to simulate
+// drift. It reports whether the project exists.
+func (s *Server) UpdateProject(id int, update func(*client.Project)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.projects[id]
+	if ok {
+		update(&p.Project)
+	}
+	return ok
+}
+
+// UpdateInbox changes a stored inbox outside of the API, to simulate drift.
+// It reports whether the inbox exists.
+func (s *Server) UpdateInbox(id int, update func(*client.Inbox)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.inboxes[id]
+	if ok {
+		update(&i.Inbox)
+	}
+	return ok
+}
+
+// UpdateSendingDomain changes a stored sending domain outside of the API,
+// for example to mark it verified. It reports whether the domain exists.
+func (s *Server) UpdateSendingDomain(id int, update func(*client.SendingDomain)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.domains[id]
+	if ok {
+		update(&d.SendingDomain)
+	}
+	return ok
+}
+
+// RemoveProject deletes a project and its inboxes outside of the API.
+func (s *Server) RemoveProject(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleteProject(id)
+}
+
+// RemoveInbox deletes an inbox outside of the API.
+func (s *Server) RemoveInbox(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inboxes, id)
+}
+
+// RemoveSendingDomain deletes a sending domain outside of the API.
+func (s *Server) RemoveSendingDomain(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.domains, id)
+}
+
+// The helpers below must be called with s.mu held.
+
+func (s *Server) createProject(accountID int, name string) *project {
+	id := s.newID()
+	p := &project{
+		accountID: accountID,
+		Project: client.Project{
+			ID:   id,
+			Name: name,
+			ShareLinks: client.ShareLink{
+				Admin:  "https://mailtrap.io/projects/" + randomHex(8) + "/admin",
+				Viewer: "https://mailtrap.io/projects/" + randomHex(8) + "/viewer",
+			},
+			Permissions: ownerPermissions,
+		},
+	}
+	s.projects[id] = p
+	return p
+}
+
+func (s *Server) deleteProject(id int) {
+	delete(s.projects, id)
+	for inboxID, i := range s.inboxes {
+		if i.ProjectID == id {
+			delete(s.inboxes, inboxID)
+		}
+	}
+}
+
+// projectView returns a copy of p with its current inboxes.
+func (s *Server) projectView(p *project) client.Project {
+	view := p.Project
+	view.Inboxes = []client.Inbox{}
+	for _, i := range s.sortedInboxes(p.accountID) {
+		if i.ProjectID == p.ID {
+			view.Inboxes = append(view.Inboxes, i.Inbox)
+		}
+	}
+	return view
+}
+
+func (s *Server) createInbox(accountID, projectID int, name, emailUsername string) *inbox {
+	if emailUsername == "" {
+		emailUsername = randomHex(7)
+	}
+
+	i := &inbox{
+		accountID: accountID,
+		Inbox: client.Inbox{
+			ID:                      s.newID(),
+			Name:                    name,
+			Username:                randomHex(7),
+			Password:                randomHex(7),
+			MaxSize:                 50,
+			Status:                  "active",
+			EmailUsername:           emailUsername,
+			EmailUsernameEnabled:    false,
+			ForwardFromEmailAddress: "a" + randomHex(4) + "@forward.mailtrap.info",
+			ProjectID:               projectID,
+			Domain:                  "sandbox.smtp.mailtrap.io",
+			POP3Domain:              "pop3.mailtrap.io",
+			EmailDomain:             "inbox.mailtrap.io",
+			SMTPPorts:               []int{25, 465, 587, 2525},
+			POP3Ports:               []int{1100, 9950},
+			Permissions:             ownerPermissions,
+		},
+	}
+	s.inboxes[i.ID] = i
+	return i
+}
+
+func (s *Server) createSendingDomain(accountID int, name string) *sendingDomain {
+	now := s.now().UTC().Truncate(time.Second)
+	d := &sendingDomain{
+		accountID: accountID,
+		SendingDomain: client.SendingDomain{
+			ID:               s.newID(),
+			Name:             name,
+			CNAME:            "mt-link." + name,
+			Status:           "pending",
+			ComplianceStatus: "pending",
+			DNSRecords:       dnsRecordsFor(name),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		},
+	}
+	s.domains[d.ID] = d
+	return d
+}
+
+// dnsRecordsFor returns the records Mailtrap asks to publish for a domain.
+func dnsRecordsFor(name string) client.DNSRecords {
+	return client.DNSRecords{
+		CNAME: []client.DNSRecord{
+			{RecordType: "CNAME", Hostname: "mt-link." + name, Value: "t.mailtrap.live", Status: "pending"},
+			{RecordType: "CNAME", Hostname: "rwmt1._domainkey." + name, Value: "rwmt1.dkim.smtp.mailtrap.live", Status: "pending"},
+			{RecordType: "CNAME", Hostname: "rwmt2._domainkey." + name, Value: "rwmt2.dkim.smtp.mailtrap.live", Status: "pending"},
+		},
+		TXT: []client.DNSRecord{
+			{RecordType: "TXT", Hostname: name, Value: "v=spf1 include:_spf.smtp.mailtrap.live ~all", Status: "pending"},
+			{RecordType: "TXT", Hostname: "_dmarc." + name, Value: "v=DMARC1; p=none; rua=mailto:dmarc@smtp.mailtrap.live; ruf=mailto:dmarc@smtp.mailtrap.live; rf=afrf; pct=100", Status: "pending"},
+		},
+		MX: []client.DNSRecord{},
+	}
+}
+
+func (s *Server) sortedAccounts() []*account {
+	accounts := make([]*account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		accounts = append(accounts, a)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+	return accounts
+}
+
+func (s *Server) sortedProjects(accountID int) []*project {
+	var projects []*project
+	for _, p := range s.projects {
+		if p.accountID == accountID {
+			projects = append(projects, p)
+		}
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	return projects
+}
+
+func (s *Server) sortedInboxes(accountID int) []*inbox {
+	var inboxes []*inbox
+	for _, i := range s.inboxes {
+		if i.accountID == accountID {
+			inboxes = append(inboxes, i)
+		}
+	}
+	sort.Slice(inboxes, func(i, j int) bool { return inboxes[i].ID < inboxes[j].ID })
+	return inboxes
+}
+
+func (s *Server) sortedSendingDomains(accountID int) []*sendingDomain {
+	var domains []*sendingDomain
+	for _, d := range s.domains {
+		if d.accountID == accountID {
+			domains = append(domains, d)
+		}
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].ID < domains[j].ID })
+	return domains
+}
+
+// randomHex returns n random bytes encoded as hex, used for credentials.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
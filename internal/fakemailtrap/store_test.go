@@ -0,0 +1,100 @@
+package fakemailtrap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+func TestAddAccount_DefaultAccessLevel(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	account := s.AddAccount("Primary")
+	if len(account.AccessLevels) != 1 || account.AccessLevels[0] != AccessOwner {
+		t.Errorf("Expected owner access, got %v", account.AccessLevels)
+	}
+
+	viewer := s.AddAccount("Shared", 10)
+	if viewer.AccessLevels[0] != 10 {
+		t.Errorf("Expected access level 10, got %v", viewer.AccessLevels)
+	}
+}
+
+func TestAddInbox_GeneratesCredentials(t *testing.T) {
+	s, account := newTestServer(t)
+	project := s.AddProject(account.ID, "Staging")
+
+	first := s.AddInbox(account.ID, project.ID, "QA")
+	second := s.AddInbox(account.ID, project.ID, "Dev")
+
+	if first.Username == "" || first.Password == "" || first.EmailUsername == "" {
+		t.Errorf("Expected generated credentials, got %+v", first)
+	}
+	if first.Password == second.Password {
+		t.Error("Expected unique passwords per inbox")
+	}
+	if first.ID == second.ID {
+		t.Error("Expected unique inbox IDs")
+	}
+}
+
+func TestUpdateInbox_Drift(t *testing.T) {
+	s, account := newTestServer(t)
+	project := s.AddProject(account.ID, "Staging")
+	inbox := s.AddInbox(account.ID, project.ID, "QA")
+
+	if !s.UpdateInbox(inbox.ID, func(i *client.Inbox) { i.Name = "Renamed" }) {
+		t.Fatal("Expected inbox to exist")
+	}
+
+	got, err := newTestClient(t, s).Inboxes.Get(context.Background(), int64(account.ID), int64(inbox.ID))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.Name != "Renamed" {
+		t.Errorf("Expected drifted name Renamed, got %s", got.Name)
+	}
+
+	if s.UpdateInbox(42, func(*client.Inbox) {}) {
+		t.Error("Expected UpdateInbox to report a missing inbox")
+	}
+}
+
+func TestRemoveProject(t *testing.T) {
+	s, account := newTestServer(t)
+	project := s.AddProject(account.ID, "Staging")
+	s.AddInbox(account.ID, project.ID, "QA")
+
+	s.RemoveProject(project.ID)
+
+	if _, ok := s.Project(project.ID); ok {
+		t.Error("Expected project to be removed")
+	}
+
+	_, err := newTestClient(t, s).Projects.Get(context.Background(), int64(account.ID), int64(project.ID))
+	if !client.IsNotFound(err) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
+
+func TestUpdateSendingDomain(t *testing.T) {
+	s, account := newTestServer(t)
+	domain := s.AddSendingDomain(account.ID, "example.com")
+
+	s.UpdateSendingDomain(domain.ID, func(d *client.SendingDomain) {
+		d.Status = "verified"
+		d.DNSStatus = client.DNSStatus{CNAME: true, TXT: true}
+	})
+
+	got, ok := s.SendingDomain(domain.ID)
+	if !ok || got.Status != "verified" || !got.DNSStatus.CNAME {
+		t.Errorf("Expected verified domain, got %+v", got)
+	}
+
+	s.RemoveSendingDomain(domain.ID)
+	if _, ok := s.SendingDomain(domain.ID); ok {
+		t.Error("Expected domain to be removed")
+	}
+}
@@ -0,0 +1,157 @@
+package fakemailtrap
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+)
+
+// tlsCertificates are the certificates of a fake served over HTTPS. They
+// are all signed by a CA generated for that fake alone.
+type tlsCertificates struct {
+	caPEM      []byte
+	clientPEM  []byte
+	clientKey  []byte
+	caCert     *x509.Certificate
+	caKey      *ecdsa.PrivateKey
+	serverCert tls.Certificate
+}
+
+// NewTLSServer starts a fake like NewServer, served over HTTPS with a
+// certificate signed by a freshly generated CA. Clients must trust CAPEM to
+// connect. When requireClientCert is true the fake also rejects connections
+// that do not present a certificate signed by that CA, such as ClientCertPEM.
+func NewTLSServer(requireClientCert bool) (*Server, error) {
+	certs, err := newTLSCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	s := newServer()
+	s.tls = certs
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certs.caCert)
+	s.httpServer.TLS = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{certs.serverCert},
+		ClientCAs:    pool,
+	}
+	if requireClientCert {
+		s.httpServer.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	s.httpServer.StartTLS()
+	s.URL = s.httpServer.URL
+	return s, nil
+}
+
+// CAPEM returns the PEM-encoded CA certificate that signed the certificates
+// of a fake started with NewTLSServer, or nil for a plain HTTP fake.
+func (s *Server) CAPEM() []byte {
+	if s.tls == nil {
+		return nil
+	}
+	return s.tls.caPEM
+}
+
+// ClientCertPEM returns a PEM-encoded client certificate and private key
+// accepted by a fake started with NewTLSServer, or nil for a plain HTTP
+// fake.
+func (s *Server) ClientCertPEM() (certPEM, keyPEM []byte) {
+	if s.tls == nil {
+		return nil, nil
+	}
+	return s.tls.clientPEM, s.tls.clientKey
+}
+
+// newTLSCertificates generates a CA together with a server certificate for
+// 127.0.0.1 and localhost and a client certificate, both signed by it.
+func newTLSCertificates() (*tlsCertificates, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate CA key: %w", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake Mailtrap CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CA certificate: %w", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	certs := &tlsCertificates{
+		caPEM:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		caCert: caCert,
+		caKey:  caKey,
+	}
+
+	serverPEM, serverKey, err := certs.issue(&x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if certs.serverCert, err = tls.X509KeyPair(serverPEM, serverKey); err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	certs.clientPEM, certs.clientKey, err = certs.issue(&x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "terraform-provider-mailtrap"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return certs, nil
+}
+
+// issue signs a leaf certificate for a new key with the CA and returns the
+// certificate and key PEM-encoded.
+func (c *tlsCertificates) issue(template *x509.Certificate) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	template.NotBefore = c.caCert.NotBefore
+	template.NotAfter = c.caCert.NotAfter
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, template, c.caCert, &key.PublicKey, c.caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate for %s: %w", template.Subject.CommonName, err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode key: %w", err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
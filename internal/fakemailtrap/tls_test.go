@@ -0,0 +1,88 @@
+package fakemailtrap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// newTestTLSServer starts an HTTPS fake with one account and closes it when
+// the test ends.
+func newTestTLSServer(t *testing.T, requireClientCert bool) *Server {
+	t.Helper()
+
+	s, err := NewTLSServer(requireClientCert)
+	if err != nil {
+		t.Fatalf("Failed to start TLS server: %v", err)
+	}
+	t.Cleanup(s.Close)
+	s.AddAccount("Primary")
+	return s
+}
+
+// testCAPool returns a pool trusting the CA of s.
+func testCAPool(t *testing.T, s *Server) *x509.CertPool {
+	t.Helper()
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(s.CAPEM()) {
+		t.Fatal("Expected CAPEM to contain a certificate")
+	}
+	return pool
+}
+
+func TestNewTLSServer_TrustsGeneratedCA(t *testing.T) {
+	s := newTestTLSServer(t, false)
+
+	untrusted := newTestClient(t, s)
+	if _, err := untrusted.Accounts.List(context.Background()); err == nil {
+		t.Error("Expected an error when the CA is not trusted")
+	}
+
+	c := newTestClient(t, s)
+	c.SetTransport(client.NewTransport(client.TransportOptions{RootCAs: testCAPool(t, s)}))
+	accounts, err := c.Accounts.List(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error with the CA trusted, got %v", err)
+	}
+	if len(accounts) != 1 {
+		t.Errorf("Expected 1 account, got %d", len(accounts))
+	}
+}
+
+func TestNewTLSServer_RequiresClientCert(t *testing.T) {
+	s := newTestTLSServer(t, true)
+	pool := testCAPool(t, s)
+
+	anonymous := newTestClient(t, s)
+	anonymous.SetTransport(client.NewTransport(client.TransportOptions{RootCAs: pool}))
+	if _, err := anonymous.Accounts.List(context.Background()); err == nil {
+		t.Error("Expected an error without a client certificate")
+	}
+
+	certPEM, keyPEM := s.ClientCertPEM()
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+
+	c := newTestClient(t, s)
+	c.SetTransport(client.NewTransport(client.TransportOptions{RootCAs: pool, Certificates: []tls.Certificate{cert}}))
+	if _, err := c.Accounts.List(context.Background()); err != nil {
+		t.Errorf("Expected no error with a client certificate, got %v", err)
+	}
+}
+
+func TestNewServer_HasNoCertificates(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	if s.CAPEM() != nil {
+		t.Error("Expected no CA for a plain HTTP fake")
+	}
+	if certPEM, keyPEM := s.ClientCertPEM(); certPEM != nil || keyPEM != nil {
+		t.Error("Expected no client certificate for a plain HTTP fake")
+	}
+}
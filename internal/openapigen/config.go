@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// config describes what to generate and where from. Relative paths are
+// resolved against the directory of the config file.
+type config struct {
+	// Package is the Go package of the generated files.
+	Package string `yaml:"package"`
+
+	// Models and Paths are the output files for the structs and the
+	// endpoint path helpers.
+	Models string `yaml:"models"`
+	Paths  string `yaml:"paths"`
+
+	// Specs lists the OpenAPI documents to read. Name qualifies path
+	// helpers whose operationId is declared by more than one spec.
+	Specs []specConfig `yaml:"specs"`
+
+	// External maps $refs to files that are not bundled with the specs,
+	// such as ../models/TestingInbox.yaml, to local schema files.
+	External map[string]string `yaml:"external"`
+
+	// Types lists the structs to generate, in output order.
+	Types []typeConfig `yaml:"types"`
+}
+
+type specConfig struct {
+	Name string `yaml:"name"`
+	File string `yaml:"file"`
+}
+
+// typeConfig names a schema and the Go struct generated from it. The schema
+// is either a local file or the request or response body of an operation.
+type typeConfig struct {
+	Name string `yaml:"name"`
+	Doc  string `yaml:"doc"`
+
+	File string `yaml:"file"`
+
+	Spec      string `yaml:"spec"`
+	Operation string `yaml:"operation"`
+	Request   bool   `yaml:"request"`
+	Response  string `yaml:"response"`
+	Items     bool   `yaml:"items"`
+
+	// Fields overrides individual properties, keyed by their dotted path
+	// from the root of the schema (for example smtp_information.data).
+	Fields map[string]fieldConfig `yaml:"fields"`
+}
+
+// fieldConfig overrides how a single property is generated.
+type fieldConfig struct {
+	// Name replaces the Go field name derived from the property name.
+	Name string `yaml:"name"`
+
+	// Type replaces the Go type derived from the schema, for example
+	// time.Time for timestamps the spec types as plain strings.
+	Type string `yaml:"type"`
+
+	// Struct and Doc name and document the struct generated for an inline
+	// object.
+	Struct string `yaml:"struct"`
+	Doc    string `yaml:"doc"`
+}
+
+// loadConfig reads the config file and makes its paths absolute.
+func loadConfig(path string) (*config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg config
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	abs := func(p string) string {
+		if p == "" || filepath.IsAbs(p) {
+			return p
+		}
+		return filepath.Join(dir, p)
+	}
+
+	cfg.Models = abs(cfg.Models)
+	cfg.Paths = abs(cfg.Paths)
+	for i := range cfg.Specs {
+		cfg.Specs[i].File = abs(cfg.Specs[i].File)
+	}
+	for ref, file := range cfg.External {
+		cfg.External[ref] = abs(file)
+	}
+	for i := range cfg.Types {
+		cfg.Types[i].File = abs(cfg.Types[i].File)
+	}
+
+	if cfg.Package == "" {
+		return nil, fmt.Errorf("%s: package is required", path)
+	}
+	return &cfg, nil
+}
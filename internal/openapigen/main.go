@@ -0,0 +1,68 @@
+// Command openapigen generates the Mailtrap client models and endpoint paths
+// from the OpenAPI specs in docs/mailtrap-open-api. It is run through
+// go generate in internal/client:
+//
+//	go generate ./internal/client
+//
+// The config file names the specs, the structs to generate and the local
+// schemas that stand in for model files the specs reference but Mailtrap
+// does not publish.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	configPath := flag.String("config", "openapigen.yaml", "path to the generator config")
+	flag.Parse()
+
+	if err := run(*configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "openapigen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configPath string) error {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	files, err := generate(cfg)
+	if err != nil {
+		return err
+	}
+	for path, src := range files {
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate renders the models and paths files, keyed by output path.
+func generate(cfg *config) (map[string][]byte, error) {
+	docs := newDocuments(cfg.External)
+	files := map[string][]byte{}
+
+	if cfg.Models != "" {
+		src, err := generateModels(cfg, docs)
+		if err != nil {
+			return nil, fmt.Errorf("models: %w", err)
+		}
+		files[cfg.Models] = src
+	}
+
+	if cfg.Paths != "" {
+		src, err := generatePaths(cfg, docs)
+		if err != nil {
+			return nil, fmt.Errorf("paths: %w", err)
+		}
+		files[cfg.Paths] = src
+	}
+
+	return files, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedFilesAreCurrent fails when the specs, the local schemas or
+// the config have changed without go generate being re-run.
+func TestGeneratedFilesAreCurrent(t *testing.T) {
+	cfg, err := loadConfig("../client/openapigen.yaml")
+	if err != nil {
+		t.Fatalf("Expected config to load, got %v", err)
+	}
+
+	files, err := generate(cfg)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for path, want := range files {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", path, err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%s is out of date, run go generate ./internal/client", filepath.Base(path))
+		}
+	}
+}
+
+// writeFixture writes files into a temporary directory and returns it.
+func writeFixture(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(strings.TrimLeft(content, "\n")), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// generateFixture runs the generator on a fixture and returns the output
+// by file name.
+func generateFixture(t *testing.T, files map[string]string) map[string]string {
+	t.Helper()
+
+	dir := writeFixture(t, files)
+	cfg, err := loadConfig(filepath.Join(dir, "openapigen.yaml"))
+	if err != nil {
+		t.Fatalf("Expected config to load, got %v", err)
+	}
+
+	generated, err := generate(cfg)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	out := map[string]string{}
+	for path, src := range generated {
+		out[filepath.Base(path)] = string(src)
+	}
+	return out
+}
+
+func TestLoadConfig_RequiresPackage(t *testing.T) {
+	dir := writeFixture(t, map[string]string{"openapigen.yaml": "models: models_gen.go\n"})
+
+	if _, err := loadConfig(filepath.Join(dir, "openapigen.yaml")); err == nil {
+		t.Error("Expected an error for a config without a package")
+	}
+}
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// structDef is a generated struct.
+type structDef struct {
+	name   string
+	doc    string
+	fields []fieldDef
+}
+
+type fieldDef struct {
+	name string
+	typ  string
+	tag  string
+}
+
+// pendingStruct is an inline object that becomes a struct of its own once
+// the struct containing it has been emitted.
+type pendingStruct struct {
+	name string
+	doc  string
+	loc  location
+	path string
+}
+
+// modelGenerator turns the configured schemas into Go structs.
+type modelGenerator struct {
+	cfg     *config
+	docs    *documents
+	specs   map[string]string
+	types   map[string]*typeConfig
+	imports map[string]bool
+	structs []structDef
+}
+
+// generateModels renders the models file.
+func generateModels(cfg *config, docs *documents) ([]byte, error) {
+	g := &modelGenerator{
+		cfg:     cfg,
+		docs:    docs,
+		specs:   map[string]string{},
+		types:   map[string]*typeConfig{},
+		imports: map[string]bool{},
+	}
+	for _, spec := range cfg.Specs {
+		g.specs[spec.Name] = spec.File
+	}
+
+	roots := make([]location, len(cfg.Types))
+	for i := range cfg.Types {
+		t := &cfg.Types[i]
+		loc, err := g.typeLocation(t)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Name, err)
+		}
+		if other, ok := g.types[loc.String()]; ok {
+			return nil, fmt.Errorf("%s and %s are generated from the same schema", other.Name, t.Name)
+		}
+		g.types[loc.String()] = t
+		roots[i] = loc
+	}
+
+	for i := range cfg.Types {
+		t := &cfg.Types[i]
+		doc := t.Doc
+		if doc == "" {
+			doc = fmt.Sprintf("%s is generated from the Mailtrap OpenAPI specs", t.Name)
+		}
+		if err := g.generateStruct(t, pendingStruct{name: t.Name, doc: doc, loc: roots[i]}); err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Name, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, cfg.Package, g.imports)
+	for _, s := range g.structs {
+		fmt.Fprintf(&buf, "\n// %s\ntype %s struct {\n", s.doc, s.name)
+		for _, f := range s.fields {
+			fmt.Fprintf(&buf, "\t%s %s `%s`\n", f.name, f.typ, f.tag)
+		}
+		buf.WriteString("}\n")
+	}
+	return format.Source(buf.Bytes())
+}
+
+// typeLocation returns the schema a type is generated from.
+func (g *modelGenerator) typeLocation(t *typeConfig) (location, error) {
+	if t.File != "" {
+		return location{file: t.File}, nil
+	}
+
+	file, ok := g.specs[t.Spec]
+	if !ok {
+		return location{}, fmt.Errorf("unknown spec %q", t.Spec)
+	}
+	op, err := findOperation(g.docs, file, t.Operation)
+	if err != nil {
+		return location{}, err
+	}
+
+	body := op.child("requestBody")
+	if !t.Request {
+		status := t.Response
+		if status == "" {
+			status = "200"
+		}
+		body = op.child("responses", status)
+	}
+	body, _, err = g.docs.resolve(body)
+	if err != nil {
+		return location{}, err
+	}
+
+	loc := body.child("content", "application/json", "schema")
+	if t.Items {
+		if loc, _, err = g.docs.resolve(loc); err != nil {
+			return location{}, err
+		}
+		loc = loc.child("items")
+	}
+	return loc, nil
+}
+
+// generateStruct emits the struct for an object schema, followed by the
+// structs of any inline objects among its properties.
+func (g *modelGenerator) generateStruct(t *typeConfig, s pendingStruct) error {
+	loc, node, err := g.docs.resolve(s.loc)
+	if err != nil {
+		return err
+	}
+	properties := mappingValue(node, "properties")
+	if properties == nil {
+		return fmt.Errorf("%s: schema has no properties", loc)
+	}
+
+	required := map[string]bool{}
+	if list := mappingValue(node, "required"); list != nil {
+		for _, item := range list.Content {
+			required[item.Value] = true
+		}
+	}
+
+	def := structDef{name: s.name, doc: s.doc}
+	var nested []pendingStruct
+	for _, property := range mappingKeys(properties) {
+		path := property
+		if s.path != "" {
+			path = s.path + "." + property
+		}
+
+		typ, nullable, inner, err := g.goType(t, s.name, loc.child("properties", property), path)
+		if err != nil {
+			return err
+		}
+		nested = append(nested, inner...)
+
+		name := goName(property)
+		if override := t.Fields[path].Name; override != "" {
+			name = override
+		}
+
+		// omitempty has no effect on struct values, so leave it off them.
+		isStruct := !strings.HasPrefix(typ, "[]") && (len(inner) > 0 || g.isModel(typ))
+		tag := property
+		if nullable || (t.Request && !required[property] && !isStruct) {
+			tag += ",omitempty"
+		}
+		def.fields = append(def.fields, fieldDef{name: name, typ: typ, tag: fmt.Sprintf("json:%q", tag)})
+	}
+	g.structs = append(g.structs, def)
+
+	for _, n := range nested {
+		if err := g.generateStruct(t, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isModel reports whether typ is one of the configured types.
+func (g *modelGenerator) isModel(typ string) bool {
+	for _, t := range g.cfg.Types {
+		if t.Name == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// goType returns the Go type of the property at loc and whether it is
+// nullable. Inline objects are returned as structs still to be generated.
+func (g *modelGenerator) goType(t *typeConfig, owner string, loc location, path string) (string, bool, []pendingStruct, error) {
+	field := t.Fields[path]
+	if field.Type != "" {
+		if pkg, _, ok := strings.Cut(field.Type, "."); ok {
+			g.imports[strings.TrimLeft(pkg, "*[]")] = true
+		}
+		return field.Type, false, nil, nil
+	}
+
+	for {
+		if named, ok := g.types[loc.String()]; ok {
+			return named.Name, false, nil, nil
+		}
+		target, ok, err := g.docs.ref(loc)
+		if err != nil {
+			return "", false, nil, err
+		}
+		if !ok {
+			break
+		}
+		loc = target
+	}
+
+	node, err := g.docs.node(loc)
+	if err != nil {
+		return "", false, nil, err
+	}
+	nullable := scalar(node, "nullable") == "true"
+
+	var typ string
+	var nested []pendingStruct
+	switch scalar(node, "type") {
+	case "integer":
+		typ = "int"
+		if scalar(node, "format") == "int64" {
+			typ = "int64"
+		}
+	case "number":
+		typ = "float64"
+	case "boolean":
+		typ = "bool"
+	case "string":
+		typ = "string"
+		if scalar(node, "format") == "date-time" {
+			typ = "time.Time"
+			g.imports["time"] = true
+		}
+	case "array":
+		items, _, inner, err := g.goType(t, owner, loc.child("items"), path)
+		if err != nil {
+			return "", false, nil, err
+		}
+		return "[]" + items, nullable, inner, nil
+	default:
+		if mappingValue(node, "properties") == nil {
+			return "interface{}", nullable, nil, nil
+		}
+		segments := strings.Split(path, ".")
+		typ = field.Struct
+		if typ == "" {
+			typ = owner + goName(segments[len(segments)-1])
+		}
+		doc := field.Doc
+		if doc == "" {
+			doc = fmt.Sprintf("%s is the %s object of %s", typ, segments[len(segments)-1], owner)
+		}
+		nested = append(nested, pendingStruct{name: typ, doc: doc, loc: loc, path: path})
+	}
+
+	if nullable {
+		typ = "*" + typ
+	}
+	return typ, nullable, nested, nil
+}
+
+// writeHeader writes the generated-code notice, package clause and imports.
+func writeHeader(buf *bytes.Buffer, pkg string, imports map[string]bool) {
+	buf.WriteString("// Code generated by openapigen from docs/mailtrap-open-api. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n", pkg)
+
+	if len(imports) == 0 {
+		return
+	}
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	if len(paths) == 1 {
+		fmt.Fprintf(buf, "\nimport %q\n", paths[0])
+		return
+	}
+	buf.WriteString("\nimport (\n")
+	for _, path := range paths {
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n")
+}
+
+// initialisms are written in upper case in Go names.
+var initialisms = map[string]bool{
+	"api": true, "cname": true, "dns": true, "html": true, "http": true,
+	"id": true, "ip": true, "json": true, "mx": true, "ok": true, "pop3": true,
+	"smtp": true, "txt": true, "url": true,
+}
+
+// goName converts a snake_case property name to an exported Go name.
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		if initialisms[strings.ToLower(word)] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// lowerName converts a snake_case name to an unexported Go name.
+func lowerName(name string) string {
+	words := splitWords(name)
+	if len(words) == 0 {
+		return ""
+	}
+	first := strings.ToLower(words[0])
+	if !initialisms[first] {
+		first = strings.ToLower(words[0][:1]) + words[0][1:]
+	}
+	return first + goName(strings.Join(words[1:], "_"))
+}
+
+func splitWords(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.' || r == ' '
+	})
+}
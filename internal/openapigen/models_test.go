@@ -0,0 +1,206 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const fixtureSpec = `
+openapi: 3.0.0
+paths:
+  /api/accounts/{account_id}/widgets:
+    parameters:
+      - $ref: '#/components/parameters/account_id'
+    get:
+      operationId: getWidgets
+      responses:
+        '200':
+          $ref: '#/components/responses/WidgetsResponse'
+    post:
+      operationId: createWidget
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [widget]
+              properties:
+                widget:
+                  type: object
+                  required: [name]
+                  properties:
+                    name:
+                      type: string
+                    color:
+                      type: string
+      responses:
+        '200':
+          $ref: '#/components/responses/WidgetsResponse'
+  /api/widgets/{slug}:
+    get:
+      operationId: sendEmail
+      parameters:
+        - name: slug
+          in: path
+          schema:
+            type: string
+components:
+  parameters:
+    account_id:
+      name: account_id
+      in: path
+      schema:
+        type: integer
+  responses:
+    WidgetsResponse:
+      content:
+        application/json:
+          schema:
+            type: array
+            items:
+              $ref: '../models/Widget.yaml'
+`
+
+var fixtureFiles = map[string]string{
+	"openapigen.yaml": `
+package: widgets
+models: models_gen.go
+paths: paths_gen.go
+specs:
+  - name: widgets
+    file: specs/widgets.yml
+  - name: sending
+    file: specs/sending.yml
+external:
+  ../models/Widget.yaml: schemas/widget.yaml
+types:
+  - name: Widget
+    doc: Widget is a widget
+    file: schemas/widget.yaml
+  - name: WidgetRequest
+    spec: widgets
+    operation: createWidget
+    request: true
+    fields:
+      widget:
+        struct: WidgetParams
+`,
+	"specs/widgets.yml": fixtureSpec,
+	"specs/sending.yml": `
+paths:
+  /api/send:
+    post:
+      operationId: sendEmail
+`,
+	"schemas/widget.yaml": `
+type: object
+properties:
+  id:
+    type: integer
+    format: int64
+  smtp_host:
+    type: string
+  weight:
+    type: number
+    nullable: true
+  created_at:
+    type: string
+    format: date-time
+  parts:
+    type: array
+    items:
+      $ref: part.yaml
+  dimensions:
+    type: object
+    properties:
+      width:
+        type: integer
+  metadata:
+    type: object
+`,
+	"schemas/part.yaml": `
+type: object
+properties:
+  name:
+    type: string
+`,
+}
+
+func TestGenerateModels(t *testing.T) {
+	out := generateFixture(t, fixtureFiles)["models_gen.go"]
+
+	for _, want := range []string{
+		"// Code generated by openapigen from docs/mailtrap-open-api. DO NOT EDIT.",
+		"package widgets",
+		`import "time"`,
+		"// Widget is a widget\ntype Widget struct {",
+		"ID         int64            `json:\"id\"`",
+		"SMTPHost   string           `json:\"smtp_host\"`",
+		"Weight     *float64         `json:\"weight,omitempty\"`",
+		"CreatedAt  time.Time        `json:\"created_at\"`",
+		"Parts      []WidgetParts    `json:\"parts\"`",
+		"Dimensions WidgetDimensions `json:\"dimensions\"`",
+		"Metadata   interface{}      `json:\"metadata\"`",
+		"// WidgetDimensions is the dimensions object of Widget",
+		"Widget WidgetParams `json:\"widget\"`",
+		"Name  string `json:\"name\"`",
+		"Color string `json:\"color,omitempty\"`",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateModels_UnknownOperation(t *testing.T) {
+	files := map[string]string{}
+	for name, content := range fixtureFiles {
+		files[name] = content
+	}
+	files["openapigen.yaml"] = `
+package: widgets
+models: models_gen.go
+specs:
+  - name: widgets
+    file: specs/widgets.yml
+types:
+  - name: Gadget
+    spec: widgets
+    operation: getGadgets
+`
+
+	dir := writeFixture(t, files)
+	cfg, err := loadConfig(dir + "/openapigen.yaml")
+	if err != nil {
+		t.Fatalf("Expected config to load, got %v", err)
+	}
+	if _, err := generate(cfg); err == nil || !strings.Contains(err.Error(), "getGadgets") {
+		t.Errorf("Expected an error naming getGadgets, got %v", err)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantUpper string
+		wantLower string
+	}{
+		{"id", "ID", "id"},
+		{"account_id", "AccountID", "accountID"},
+		{"pop3_domain", "POP3Domain", "pop3Domain"},
+		{"html_source_path", "HTMLSourcePath", "htmlSourcePath"},
+		{"sending_domain_id", "SendingDomainID", "sendingDomainID"},
+		{"getProjects", "GetProjects", "getProjects"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := goName(tt.name); got != tt.wantUpper {
+				t.Errorf("Expected goName %q, got %q", tt.wantUpper, got)
+			}
+			if got := lowerName(tt.name); got != tt.wantLower {
+				t.Errorf("Expected lowerName %q, got %q", tt.wantLower, got)
+			}
+		})
+	}
+}
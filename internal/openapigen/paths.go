@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strings"
+)
+
+// httpMethods are the operation keys of an OpenAPI path item.
+var httpMethods = map[string]bool{
+	"get": true, "put": true, "post": true, "patch": true,
+	"delete": true, "head": true, "options": true, "trace": true,
+}
+
+var pathParamRE = regexp.MustCompile(`\{([^}]+)\}`)
+
+// operation is an API operation declared by one of the specs.
+type operation struct {
+	spec   string
+	id     string
+	method string
+	path   string
+	loc    location
+}
+
+type pathParam struct {
+	name    string
+	goType  string
+	verb    string
+	escaped bool
+}
+
+// findOperation returns the location of the operation with the given
+// operationId in a spec.
+func findOperation(docs *documents, file, id string) (location, error) {
+	ops, err := operations(docs, "", file)
+	if err != nil {
+		return location{}, err
+	}
+	for _, op := range ops {
+		if op.id == id {
+			return op.loc, nil
+		}
+	}
+	return location{}, fmt.Errorf("%s declares no operation %q", file, id)
+}
+
+// operations lists the operations of a spec in document order.
+func operations(docs *documents, spec, file string) ([]operation, error) {
+	root := location{file: file}
+	paths, err := docs.node(root.child("paths"))
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []operation
+	for _, path := range mappingKeys(paths) {
+		item, err := docs.node(root.child("paths", path))
+		if err != nil {
+			return nil, err
+		}
+		for _, method := range mappingKeys(item) {
+			if !httpMethods[method] {
+				continue
+			}
+			loc := root.child("paths", path, method)
+			op, err := docs.node(loc)
+			if err != nil {
+				return nil, err
+			}
+			id := scalar(op, "operationId")
+			if id == "" {
+				return nil, fmt.Errorf("%s: %s %s has no operationId", file, strings.ToUpper(method), path)
+			}
+			ops = append(ops, operation{spec: spec, id: id, method: method, path: path, loc: loc})
+		}
+	}
+	return ops, nil
+}
+
+// generatePaths renders one path helper per operation of every spec.
+// Helpers for an operationId declared by more than one spec are qualified
+// with the spec name.
+func generatePaths(cfg *config, docs *documents) ([]byte, error) {
+	var all []operation
+	counts := map[string]int{}
+	for _, spec := range cfg.Specs {
+		ops, err := operations(docs, spec.Name, spec.File)
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range ops {
+			counts[op.id]++
+		}
+		all = append(all, ops...)
+	}
+
+	imports := map[string]bool{}
+	seen := map[string]bool{}
+	var body bytes.Buffer
+	for _, op := range all {
+		name := lowerName(op.id) + "Path"
+		if counts[op.id] > 1 {
+			name = lowerName(op.spec) + goName(op.id) + "Path"
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s: operationId %q is declared twice", op.spec, op.id)
+		}
+		seen[name] = true
+
+		params, err := pathParams(docs, op)
+		if err != nil {
+			return nil, err
+		}
+
+		tmpl := op.path
+		var args, decls []string
+		for i, p := range params {
+			tmpl = strings.Replace(tmpl, "{"+p.name+"}", p.verb, 1)
+
+			arg := lowerName(p.name)
+			if p.escaped {
+				imports["net/url"] = true
+				args = append(args, "url.PathEscape("+arg+")")
+			} else {
+				args = append(args, arg)
+			}
+
+			if i+1 < len(params) && params[i+1].goType == p.goType {
+				decls = append(decls, arg)
+			} else {
+				decls = append(decls, arg+" "+p.goType)
+			}
+		}
+
+		fmt.Fprintf(&body, "\n// %s returns the path of %s %s.\n", name, strings.ToUpper(op.method), op.path)
+		fmt.Fprintf(&body, "func %s(%s) string {\n", name, strings.Join(decls, ", "))
+		if len(params) == 0 {
+			fmt.Fprintf(&body, "\treturn %q\n}\n", op.path)
+			continue
+		}
+		imports["fmt"] = true
+		fmt.Fprintf(&body, "\treturn fmt.Sprintf(%q, %s)\n}\n", tmpl, strings.Join(args, ", "))
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, cfg.Package, imports)
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// pathParams returns the parameters in the path of op, in path order.
+// Parameters declared on the operation take precedence over ones declared
+// on the path item.
+func pathParams(docs *documents, op operation) ([]pathParam, error) {
+	declared := map[string]location{}
+	pathItem := location{file: op.loc.file, pointer: op.loc.pointer[:len(op.loc.pointer)-1]}
+	for _, owner := range []location{pathItem, op.loc} {
+		list, err := docs.node(owner.child("parameters"))
+		if err != nil {
+			continue
+		}
+		for i := range list.Content {
+			loc, node, err := docs.resolve(owner.child("parameters", fmt.Sprint(i)))
+			if err != nil {
+				return nil, err
+			}
+			if scalar(node, "in") == "path" {
+				declared[scalar(node, "name")] = loc
+			}
+		}
+	}
+
+	var params []pathParam
+	for _, match := range pathParamRE.FindAllStringSubmatch(op.path, -1) {
+		p := pathParam{name: match[1], goType: "string", verb: "%s", escaped: true}
+		if loc, ok := declared[p.name]; ok {
+			_, schema, err := docs.resolve(loc.child("schema"))
+			if err == nil && scalar(schema, "type") == "integer" {
+				p.goType, p.verb, p.escaped = "int64", "%d", false
+			}
+		}
+		params = append(params, p)
+	}
+	return params, nil
+}
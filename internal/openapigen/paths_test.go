@@ -0,0 +1,37 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGeneratePaths(t *testing.T) {
+	out := generateFixture(t, fixtureFiles)["paths_gen.go"]
+
+	for _, want := range []string{
+		"// getWidgetsPath returns the path of GET /api/accounts/{account_id}/widgets.\nfunc getWidgetsPath(accountID int64) string {\n\treturn fmt.Sprintf(\"/api/accounts/%d/widgets\", accountID)\n}",
+		"func createWidgetPath(accountID int64) string {",
+		"func widgetsSendEmailPath(slug string) string {\n\treturn fmt.Sprintf(\"/api/widgets/%s\", url.PathEscape(slug))\n}",
+		"func sendingSendEmailPath() string {\n\treturn \"/api/send\"\n}",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestGeneratePaths_MissingOperationID(t *testing.T) {
+	files := map[string]string{
+		"openapigen.yaml": "package: widgets\npaths: paths_gen.go\nspecs:\n  - name: widgets\n    file: widgets.yml\n",
+		"widgets.yml":     "paths:\n  /api/widgets:\n    get:\n      summary: List widgets\n",
+	}
+
+	dir := writeFixture(t, files)
+	cfg, err := loadConfig(dir + "/openapigen.yaml")
+	if err != nil {
+		t.Fatalf("Expected config to load, got %v", err)
+	}
+	if _, err := generate(cfg); err == nil || !strings.Contains(err.Error(), "operationId") {
+		t.Errorf("Expected a missing operationId error, got %v", err)
+	}
+}
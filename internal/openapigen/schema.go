@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// location addresses a node inside a YAML document by file and JSON pointer.
+type location struct {
+	file    string
+	pointer []string
+}
+
+func (l location) String() string {
+	keys := make([]string, len(l.pointer))
+	for i, key := range l.pointer {
+		keys[i] = escapePointer(key)
+	}
+	return l.file + "#/" + strings.Join(keys, "/")
+}
+
+// child returns the location of a key below l.
+func (l location) child(keys ...string) location {
+	pointer := make([]string, 0, len(l.pointer)+len(keys))
+	pointer = append(pointer, l.pointer...)
+	pointer = append(pointer, keys...)
+	return location{file: l.file, pointer: pointer}
+}
+
+// documents loads YAML files once and resolves $refs between them.
+type documents struct {
+	external map[string]string
+	files    map[string]*yaml.Node
+}
+
+func newDocuments(external map[string]string) *documents {
+	return &documents{external: external, files: map[string]*yaml.Node{}}
+}
+
+// node returns the YAML node at loc.
+func (d *documents) node(loc location) (*yaml.Node, error) {
+	root, ok := d.files[loc.file]
+	if !ok {
+		raw, err := os.ReadFile(loc.file)
+		if err != nil {
+			return nil, err
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", loc.file, err)
+		}
+		if len(doc.Content) == 0 {
+			return nil, fmt.Errorf("%s is empty", loc.file)
+		}
+		root = doc.Content[0]
+		d.files[loc.file] = root
+	}
+
+	node := root
+	for _, key := range loc.pointer {
+		var next *yaml.Node
+		if node.Kind == yaml.SequenceNode {
+			if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(node.Content) {
+				next = node.Content[i]
+			}
+		} else {
+			next = mappingValue(node, key)
+		}
+		if next == nil {
+			return nil, fmt.Errorf("%s does not exist", loc)
+		}
+		node = next
+	}
+	return node, nil
+}
+
+// ref returns the target of the $ref at loc, if there is one. References to
+// files listed in the external map are redirected to their local copy.
+func (d *documents) ref(loc location) (location, bool, error) {
+	node, err := d.node(loc)
+	if err != nil {
+		return location{}, false, err
+	}
+	refNode := mappingValue(node, "$ref")
+	if refNode == nil {
+		return location{}, false, nil
+	}
+
+	file, fragment, _ := strings.Cut(refNode.Value, "#")
+	target := location{file: loc.file}
+	if file != "" {
+		if local, ok := d.external[file]; ok {
+			target.file = local
+		} else {
+			target.file = filepath.Join(filepath.Dir(loc.file), file)
+		}
+	}
+	for _, key := range strings.Split(strings.TrimPrefix(fragment, "/"), "/") {
+		if key != "" {
+			target.pointer = append(target.pointer, unescapePointer(key))
+		}
+	}
+	return target, true, nil
+}
+
+// resolve follows $refs from loc until it reaches a node without one.
+func (d *documents) resolve(loc location) (location, *yaml.Node, error) {
+	for i := 0; i < 32; i++ {
+		target, ok, err := d.ref(loc)
+		if err != nil {
+			return location{}, nil, err
+		}
+		if !ok {
+			node, err := d.node(loc)
+			return loc, node, err
+		}
+		loc = target
+	}
+	return location{}, nil, fmt.Errorf("%s: reference cycle", loc)
+}
+
+// mappingValue returns the value of key in a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// mappingKeys returns the keys of a mapping node in document order.
+func mappingKeys(node *yaml.Node) []string {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys
+}
+
+// scalar returns the value of a scalar under key, or "".
+func scalar(node *yaml.Node, key string) string {
+	if v := mappingValue(node, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+// escapePointer and unescapePointer apply the JSON pointer escaping of
+// RFC 6901, which paths such as /api/accounts need inside a $ref.
+func escapePointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
+func unescapePointer(key string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(key)
+}
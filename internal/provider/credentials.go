@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultProfile is the credentials file profile used when neither the
+	// profile attribute nor MAILTRAP_PROFILE is set.
+	defaultProfile = "default"
+
+	// credentialsFileEnvVar overrides the location of the credentials file.
+	credentialsFileEnvVar = "MAILTRAP_CONFIG_FILE"
+)
+
+// credentialsKeys are the settings a credentials file profile may hold.
+var credentialsKeys = map[string]bool{
+	"api_token":       true,
+	"account_id":      true,
+	"base_url":        true,
+	"sending_api_url": true,
+	"bulk_api_url":    true,
+	"sandbox_api_url": true,
+}
+
+// credentialsProfile holds the settings of one profile of the credentials
+// file, keyed by provider attribute name.
+type credentialsProfile map[string]string
+
+// credentialsFilePath returns the path of the credentials file:
+// MAILTRAP_CONFIG_FILE when set, ~/.mailtrap/credentials otherwise.
+func credentialsFilePath() (string, error) {
+	if path := os.Getenv(credentialsFileEnvVar); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the home directory: %w", err)
+	}
+	return filepath.Join(home, ".mailtrap", "credentials"), nil
+}
+
+// loadCredentialsProfile reads a profile from the credentials file. A
+// missing file or profile is only an error when required is set, that is
+// when the profile was chosen explicitly; otherwise an empty profile is
+// returned. Likewise an unreadable file, a malformed line or an unknown
+// setting is only an error when strict is set, that is when the profile or
+// the file was chosen explicitly; otherwise it is skipped and returned as a
+// warning, so that a stray ~/.mailtrap/credentials does not break a
+// configuration that never asked for it.
+func loadCredentialsProfile(path, profile string, required, strict bool) (credentialsProfile, []error, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !required {
+			return credentialsProfile{}, nil, nil
+		}
+		err = fmt.Errorf("failed to open credentials file: %w", err)
+		if !strict {
+			return credentialsProfile{}, []error{err}, nil
+		}
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	profiles, warnings, err := parseCredentials(f, strict)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for i, warning := range warnings {
+		warnings[i] = fmt.Errorf("%s: %w", path, warning)
+	}
+
+	settings, ok := profiles[profile]
+	if !ok {
+		if required {
+			return nil, nil, fmt.Errorf("%s has no profile %q", path, profile)
+		}
+		return credentialsProfile{}, warnings, nil
+	}
+	return settings, warnings, nil
+}
+
+// parseCredentials parses a credentials file into its profiles. The format
+// is the common subset of INI and TOML:
+//
+//	[default]
+//	api_token = "0123456789abcdef"
+//	account_id = 12345
+//
+//	[staging]
+//	api_token = fedcba9876543210
+//	base_url  = "https://mailtrap.staging.internal"
+//
+// Values may be double-quoted, single-quoted or bare. Lines starting with
+// # or ; are comments. Unless strict is set, an invalid line is skipped
+// and returned as a warning instead of failing the whole file.
+func parseCredentials(r io.Reader, strict bool) (map[string]credentialsProfile, []error, error) {
+	profiles := map[string]credentialsProfile{}
+	var current credentialsProfile
+	var warnings []error
+
+	// invalid reports an invalid line: the error to fail with when strict,
+	// nil after recording a warning otherwise
+	invalid := func(line int, format string, args ...interface{}) error {
+		err := fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+		if strict {
+			return err
+		}
+		warnings = append(warnings, err)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			name, ok := strings.CutSuffix(stripComment(text), "]")
+			name = strings.TrimSpace(strings.Trim(strings.TrimPrefix(name, "["), `"'`))
+			if !ok || name == "" {
+				if err := invalid(line, "invalid profile header %q", text); err != nil {
+					return nil, nil, err
+				}
+				// Settings up to the next valid header belong to no profile
+				current = nil
+				continue
+			}
+			if existing, exists := profiles[name]; exists {
+				if err := invalid(line, "profile %q is defined twice", name); err != nil {
+					return nil, nil, err
+				}
+				current = existing
+				continue
+			}
+			current = credentialsProfile{}
+			profiles[name] = current
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			if err := invalid(line, "expected key = value"); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		key = strings.TrimSpace(key)
+		if current == nil {
+			if err := invalid(line, "%s is set outside of a profile", key); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if !credentialsKeys[key] {
+			if err := invalid(line, "unknown setting %q", key); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		value, err := parseCredentialsValue(strings.TrimSpace(value))
+		if err != nil {
+			if err := invalid(line, "%s: %s", key, err); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		current[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		if strict {
+			return nil, nil, err
+		}
+		warnings = append(warnings, err)
+	}
+
+	return profiles, warnings, nil
+}
+
+// parseCredentialsValue unquotes a value. A quoted string ends at its first
+// unescaped closing quote and may only be followed by an inline comment;
+// bare values end at an inline comment.
+func parseCredentialsValue(value string) (string, error) {
+	var end int
+	switch {
+	case strings.HasPrefix(value, `"`):
+		end = closingQuote(value)
+	case strings.HasPrefix(value, "'"):
+		// Single-quoted strings are literal, without escapes
+		end = strings.Index(value[1:], "'") + 1
+	default:
+		return stripComment(value), nil
+	}
+	if end <= 0 {
+		return "", fmt.Errorf("unterminated string")
+	}
+
+	if rest := strings.TrimSpace(value[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") && !strings.HasPrefix(rest, ";") {
+		return "", fmt.Errorf("unexpected %q after the closing quote", rest)
+	}
+	if value[0] == '\'' {
+		return value[1:end], nil
+	}
+	return strconv.Unquote(value[:end+1])
+}
+
+// closingQuote returns the index of the first unescaped double quote after
+// the opening one, or -1 when the string is unterminated.
+func closingQuote(value string) int {
+	for i := 1; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+// stripComment removes a trailing # or ; comment.
+func stripComment(s string) string {
+	if i := strings.IndexAny(s, "#;"); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
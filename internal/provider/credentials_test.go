@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseCredentials(t *testing.T) {
+	input := `
+# Mailtrap credentials
+[default]
+api_token = "default-token"
+account_id = 12345
+
+; INI style, bare values
+[staging]
+api_token  = staging-token   # inline comment
+base_url   = 'https://mailtrap.staging.internal'
+
+["quoted name"]
+sandbox_api_url = "https://sandbox.staging.internal"
+
+[comments]
+api_token = "abc" # "prod"
+base_url = 'https://mailtrap.io' ; 'production'
+sending_api_url = "https://send.mailtrap.io/\"v2\"" # escaped
+`
+
+	profiles, _, err := parseCredentials(strings.NewReader(input), true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := map[string]credentialsProfile{
+		"default":     {"api_token": "default-token", "account_id": "12345"},
+		"staging":     {"api_token": "staging-token", "base_url": "https://mailtrap.staging.internal"},
+		"quoted name": {"sandbox_api_url": "https://sandbox.staging.internal"},
+		"comments":    {"api_token": "abc", "base_url": "https://mailtrap.io", "sending_api_url": `https://send.mailtrap.io/"v2"`},
+	}
+	if len(profiles) != len(expected) {
+		t.Fatalf("Expected %d profiles, got %d", len(expected), len(profiles))
+	}
+	for name, settings := range expected {
+		for key, value := range settings {
+			if got := profiles[name][key]; got != value {
+				t.Errorf("Expected %s.%s to be %q, got %q", name, key, value, got)
+			}
+		}
+	}
+}
+
+func TestParseCredentials_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"setting outside profile", "api_token = x", "outside of a profile"},
+		{"unknown setting", "[default]\napi_tokn = x", `unknown setting "api_tokn"`},
+		{"missing equals", "[default]\napi_token", "expected key = value"},
+		{"bad header", "[default", "invalid profile header"},
+		{"empty header", "[]", "invalid profile header"},
+		{"duplicate profile", "[default]\n[default]", "defined twice"},
+		{"unterminated string", "[default]\napi_token = \"abc", "unterminated string"},
+		{"escaped closing quote", "[default]\napi_token = \"abc\\\"", "unterminated string"},
+		{"unterminated literal string", "[default]\napi_token = 'abc", "unterminated string"},
+		{"text after string", "[default]\napi_token = \"abc\" def", `unexpected "def" after the closing quote`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := parseCredentials(strings.NewReader(tt.input), true)
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestParseCredentials_Lenient(t *testing.T) {
+	input := `
+api_token = outside
+[default
+[default]
+api_token = "default-token" # "prod"
+api_tokn = typo
+token
+region = "eu"
+base_url = "unterminated
+[default]
+account_id = 12345
+`
+
+	profiles, warnings, err := parseCredentials(strings.NewReader(input), false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{
+		"line 2: api_token is set outside of a profile",
+		"line 3: invalid profile header",
+		`line 6: unknown setting "api_tokn"`,
+		"line 7: expected key = value",
+		`line 8: unknown setting "region"`,
+		"line 9: base_url: unterminated string",
+		`line 10: profile "default" is defined twice`,
+	}
+	if len(warnings) != len(expected) {
+		t.Fatalf("Expected %d warnings, got %v", len(expected), warnings)
+	}
+	for i, warning := range expected {
+		if !strings.Contains(warnings[i].Error(), warning) {
+			t.Errorf("Expected warning containing %q, got %v", warning, warnings[i])
+		}
+	}
+
+	if got := profiles["default"]["api_token"]; got != "default-token" {
+		t.Errorf("Expected the valid api_token to be kept, got %q", got)
+	}
+	if got := profiles["default"]["account_id"]; got != "12345" {
+		t.Errorf("Expected the repeated profile to be merged, got account_id %q", got)
+	}
+	if _, ok := profiles["default"]["base_url"]; ok {
+		t.Error("Expected the invalid base_url to be skipped")
+	}
+}
+
+func TestLoadCredentialsProfile(t *testing.T) {
+	credentialsFile := testCredentialsFile(t, "[default]\napi_token = default-token\n")
+	invalidFile := testCredentialsFile(t, "[default]\napi_token = default-token\nregion = eu\n")
+	missingFile := filepath.Join(t.TempDir(), "credentials")
+
+	tests := []struct {
+		name     string
+		path     string
+		profile  string
+		required bool
+		strict   bool
+		token    string
+		warnings int
+		hasError bool
+	}{
+		{"default profile", credentialsFile, "default", false, false, "default-token", 0, false},
+		{"missing optional profile", credentialsFile, "staging", false, false, "", 0, false},
+		{"missing required profile", credentialsFile, "staging", true, true, "", 0, true},
+		{"missing optional file", missingFile, "default", false, false, "", 0, false},
+		{"missing required file", missingFile, "default", true, true, "", 0, true},
+		{"invalid implicit file", invalidFile, "default", false, false, "default-token", 1, false},
+		{"invalid chosen file", invalidFile, "default", false, true, "", 0, true},
+		{"invalid chosen profile", invalidFile, "default", true, true, "", 0, true},
+		{"unreadable implicit file", t.TempDir(), "default", false, false, "", 1, false},
+		{"unreadable chosen file", t.TempDir(), "default", false, true, "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, warnings, err := loadCredentialsProfile(tt.path, tt.profile, tt.required, tt.strict)
+			if tt.hasError {
+				if err == nil {
+					t.Error("Expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(warnings) != tt.warnings {
+				t.Errorf("Expected %d warnings, got %v", tt.warnings, warnings)
+			}
+			if profile["api_token"] != tt.token {
+				t.Errorf("Expected token %q, got %q", tt.token, profile["api_token"])
+			}
+		})
+	}
+}
+
+func TestCredentialsFilePath(t *testing.T) {
+	t.Setenv(credentialsFileEnvVar, "/etc/mailtrap/credentials")
+	if got, _ := credentialsFilePath(); got != "/etc/mailtrap/credentials" {
+		t.Errorf("Expected the MAILTRAP_CONFIG_FILE path, got %s", got)
+	}
+
+	t.Setenv(credentialsFileEnvVar, "")
+	t.Setenv("HOME", "/home/mailtrap")
+	if got, _ := credentialsFilePath(); got != filepath.Join("/home/mailtrap", ".mailtrap", "credentials") {
+		t.Errorf("Expected the file in the home directory, got %s", got)
+	}
+}
+
+func TestMailtrapProvider_Configure_Profile(t *testing.T) {
+	testAccPreCheck(t)
+	server, account := testAccServer(t)
+
+	t.Setenv(credentialsFileEnvVar, testCredentialsFile(t, `
+[default]
+api_token = "wrong-token"
+
+[fake]
+api_token  = "`+server.Token()+`"
+account_id = `+strconv.Itoa(account.ID)+`
+base_url   = "`+server.URL+`"
+`))
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"profile":   tftypes.NewValue(tftypes.String, "fake"),
+		"api_token": tftypes.NewValue(tftypes.String, nil),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	providerData := resp.ResourceData.(*ProviderData)
+	if providerData.AccountID != int64(account.ID) {
+		t.Errorf("Expected account ID %d from the profile, got %d", account.ID, providerData.AccountID)
+	}
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_ProfileFromEnv(t *testing.T) {
+	testAccPreCheck(t)
+	server, _ := testAccServer(t)
+
+	t.Setenv(credentialsFileEnvVar, testCredentialsFile(t, `
+[fake]
+api_token = "`+server.Token()+`"
+base_url  = "`+server.URL+`"
+`))
+	t.Setenv("MAILTRAP_PROFILE", "fake")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"api_token": tftypes.NewValue(tftypes.String, nil),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_ProfilePrecedence(t *testing.T) {
+	testAccPreCheck(t)
+	server, account := testAccServer(t)
+
+	t.Setenv(credentialsFileEnvVar, testCredentialsFile(t, `
+[default]
+api_token  = "profile-token"
+account_id = 111
+base_url   = "https://profile.invalid"
+`))
+	t.Setenv("MAILTRAP_ACCOUNT_ID", strconv.Itoa(account.ID))
+
+	// The attribute beats the environment, which beats the profile.
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	providerData := resp.ResourceData.(*ProviderData)
+	if providerData.AccountID != int64(account.ID) {
+		t.Errorf("Expected account ID %d from MAILTRAP_ACCOUNT_ID, got %d", account.ID, providerData.AccountID)
+	}
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_ProfileErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials string
+		profile     string
+	}{
+		{"missing profile", "[default]\napi_token = x\n", "staging"},
+		{"missing file", "", "staging"},
+		{"invalid file", "[default]\ntoken = x\n", "default"},
+		{"invalid account ID", "[staging]\naccount_id = abc\n", "staging"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testAccPreCheck(t)
+			if tt.credentials != "" {
+				t.Setenv(credentialsFileEnvVar, testCredentialsFile(t, tt.credentials))
+			}
+
+			resp := testProviderConfigure(t, map[string]tftypes.Value{
+				"profile": tftypes.NewValue(tftypes.String, tt.profile),
+			})
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error")
+			}
+
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root("profile")) {
+				t.Errorf("Expected the error on profile, got %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestMailtrapProvider_Configure_MissingDefaultProfile(t *testing.T) {
+	testAccPreCheck(t)
+	server, _ := testAccServer(t)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors without a credentials file, got %v", resp.Diagnostics.Errors())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_InvalidDefaultCredentialsFile(t *testing.T) {
+	testAccPreCheck(t)
+	server, _ := testAccServer(t)
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(credentialsFileEnvVar, "")
+	if err := os.Mkdir(filepath.Join(home, ".mailtrap"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".mailtrap", "credentials"), []byte("[default]\nregion = eu\naccount_id = abc\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors for the implicit credentials file, got %v", resp.Diagnostics.Errors())
+	}
+	if len(resp.Diagnostics.Warnings()) != 2 {
+		t.Errorf("Expected warnings for the unknown setting and the account ID, got %v", resp.Diagnostics.Warnings())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+// testCredentialsFile writes a credentials file to a temporary directory
+// and returns its path.
+func testCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(credentialsFile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return credentialsFile
+}
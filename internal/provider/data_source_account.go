@@ -6,12 +6,51 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &AccountDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AccountDataSource{}
+
+// accessLevelRoles names the access levels Mailtrap reports for an account.
+var accessLevelRoles = map[int]string{
+	1000: "owner",
+	100:  "admin",
+	10:   "viewer",
+}
+
+// accessLevelRole returns the role name of an access level, or "unknown"
+// for levels Mailtrap has not documented.
+func accessLevelRole(level int) string {
+	if role, ok := accessLevelRoles[level]; ok {
+		return role
+	}
+	return "unknown"
+}
+
+// convertAccessLevelsToTerraform returns the access levels of an account
+// and their role names as Terraform lists.
+func convertAccessLevelsToTerraform(ctx context.Context, levels []int) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]int64, len(levels))
+	roles := make([]string, len(levels))
+	for i, level := range levels {
+		values[i] = int64(level)
+		roles[i] = accessLevelRole(level)
+	}
+
+	levelsList, d := types.ListValueFrom(ctx, types.Int64Type, values)
+	diags.Append(d...)
+	rolesList, d := types.ListValueFrom(ctx, types.StringType, roles)
+	diags.Append(d...)
+
+	return levelsList, rolesList, diags
+}
 
 func NewAccountDataSource() datasource.DataSource {
 	return &AccountDataSource{}
@@ -24,8 +63,10 @@ type AccountDataSource struct {
 
 // AccountDataSourceModel describes the data source data model.
 type AccountDataSourceModel struct {
-	ID   types.Int64  `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	AccessLevels types.List   `tfsdk:"access_levels"`
+	Roles        types.List   `tfsdk:"roles"`
 }
 
 func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -39,17 +80,47 @@ func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Account identifier",
-				Required:            true,
+				MarkdownDescription: "Account identifier. Exactly one of `id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Account name",
+				MarkdownDescription: "Account name. Exactly one of `id` and `name` must be set; the name must match a single account.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"access_levels": schema.ListAttribute{
+				MarkdownDescription: "Access levels of the API token on the account",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "Role names of `access_levels`: `owner` (1000), `admin` (100), `viewer` (10) or `unknown`",
+				ElementType:         types.StringType,
 				Computed:            true,
 			},
 		},
 	}
 }
 
+func (d *AccountDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AccountDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Account Lookup",
+			"Exactly one of id and name must be set to look up an account.",
+		)
+	}
+}
+
 func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -79,34 +150,70 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Get all accounts
-	var accounts []client.Account
-	err := d.client.Get("/api/accounts", &accounts)
+	accounts, err := d.client.Accounts.List(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read accounts, got error: %s", err))
 		return
 	}
 
-	// Find the account with matching ID
-	accountID := data.ID.ValueInt64()
 	var foundAccount *client.Account
-	for _, account := range accounts {
-		if int64(account.ID) == accountID {
-			foundAccount = &account
-			break
+	if !data.ID.IsNull() {
+		// Find the account with matching ID
+		accountID := data.ID.ValueInt64()
+		for i := range accounts {
+			if int64(accounts[i].ID) == accountID {
+				foundAccount = &accounts[i]
+				break
+			}
 		}
-	}
 
-	if foundAccount == nil {
-		resp.Diagnostics.AddError(
-			"Account Not Found",
-			fmt.Sprintf("Account with ID %d not found", accountID),
-		)
-		return
+		if foundAccount == nil {
+			resp.Diagnostics.AddError(
+				"Account Not Found",
+				fmt.Sprintf("Account with ID %d not found", accountID),
+			)
+			return
+		}
+	} else {
+		// Find the account with matching name
+		name := data.Name.ValueString()
+		var matches []client.Account
+		for _, account := range accounts {
+			if account.Name == name {
+				matches = append(matches, account)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Account Not Found",
+				fmt.Sprintf("Account named %q not found. Accessible accounts: %s", name, describeAccounts(accounts)),
+			)
+			return
+		case 1:
+			foundAccount = &matches[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple Accounts Found",
+				fmt.Sprintf("Several accounts are named %q: %s. Look the account up by id instead.", name, describeAccounts(matches)),
+			)
+			return
+		}
 	}
 
 	// Update model with account data
+	data.ID = types.Int64Value(int64(foundAccount.ID))
 	data.Name = types.StringValue(foundAccount.Name)
 
+	accessLevels, roles, diags := convertAccessLevelsToTerraform(ctx, foundAccount.AccessLevels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AccessLevels = accessLevels
+	data.Roles = roles
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
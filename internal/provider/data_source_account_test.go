@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
 )
 
 func TestAccountDataSource_Metadata(t *testing.T) {
@@ -135,4 +138,116 @@ func TestAccountDataSourceModel(t *testing.T) {
 	if model.Name.ValueString() != "Test Account" {
 		t.Errorf("Expected name 'Test Account', got %s", model.Name.ValueString())
 	}
-}
\ No newline at end of file
+}
+
+func TestAccAccountDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + fmt.Sprintf(`
+data "mailtrap_account" "test" {
+  id = %d
+}
+`, account.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.mailtrap_account.test", "id", fmt.Sprint(account.ID)),
+					tfresource.TestCheckResourceAttr("data.mailtrap_account.test", "name", account.Name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccountDataSource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    AccountDataSourceModel
+		hasError bool
+	}{
+		{"id", AccountDataSourceModel{ID: types.Int64Value(1), Name: types.StringNull()}, false},
+		{"name", AccountDataSourceModel{ID: types.Int64Null(), Name: types.StringValue("Test Account")}, false},
+		{"both", AccountDataSourceModel{ID: types.Int64Value(1), Name: types.StringValue("Test Account")}, true},
+		{"neither", AccountDataSourceModel{ID: types.Int64Null(), Name: types.StringNull()}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &AccountDataSource{}
+			tt.model.AccessLevels = types.ListNull(types.Int64Type)
+			tt.model.Roles = types.ListNull(types.StringType)
+
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(context.Background(), datasource.ValidateConfigRequest{Config: testDataSourceConfig(t, d, tt.model)}, resp)
+
+			if resp.Diagnostics.HasError() != tt.hasError {
+				t.Errorf("Expected error %t, got %v", tt.hasError, resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestAccountDataSource_Read_ByName(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	server.AddAccount("Production")
+	staging := server.AddAccount("Staging", fakemailtrap.AccessAdmin)
+	server.AddAccount("Duplicate")
+	server.AddAccount("Duplicate")
+
+	d := &AccountDataSource{client: testServerClient(server)}
+	model := AccountDataSourceModel{
+		ID:           types.Int64Null(),
+		Name:         types.StringValue("Staging"),
+		AccessLevels: types.ListNull(types.Int64Type),
+		Roles:        types.ListNull(types.StringType),
+	}
+
+	resp := testDataSourceRead(t, d, model)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data AccountDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.ID.ValueInt64() != int64(staging.ID) {
+		t.Errorf("Expected ID %d, got %d", staging.ID, data.ID.ValueInt64())
+	}
+	var roles []string
+	resp.Diagnostics.Append(data.Roles.ElementsAs(context.Background(), &roles, false)...)
+	if len(roles) != 1 || roles[0] != "admin" {
+		t.Errorf("Expected roles [admin], got %v", roles)
+	}
+
+	for _, name := range []string{"Duplicate", "Missing"} {
+		model.Name = types.StringValue(name)
+		if resp := testDataSourceRead(t, d, model); !resp.Diagnostics.HasError() {
+			t.Errorf("Expected error for account name %s", name)
+		}
+	}
+}
+
+func TestAccAccountDataSource_ByName(t *testing.T) {
+	server, account := testAccServer(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + fmt.Sprintf(`
+data "mailtrap_account" "test" {
+  name = %q
+}
+`, account.Name),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.mailtrap_account.test", "id", fmt.Sprint(account.ID)),
+					tfresource.TestCheckResourceAttr("data.mailtrap_account.test", "roles.0", "owner"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AccountsDataSource{}
+
+func NewAccountsDataSource() datasource.DataSource {
+	return &AccountsDataSource{}
+}
+
+// AccountsDataSource defines the data source implementation.
+type AccountsDataSource struct {
+	client *client.Client
+}
+
+// AccountsDataSourceModel describes the data source data model.
+type AccountsDataSourceModel struct {
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Accounts  []AccountsAccountModel `tfsdk:"accounts"`
+}
+
+// AccountsAccountModel describes one account of the accounts data source.
+type AccountsAccountModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	AccessLevels types.List   `tfsdk:"access_levels"`
+	Roles        types.List   `tfsdk:"roles"`
+}
+
+func (d *AccountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *AccountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the accounts the API token can access",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) the account name must match",
+				Optional:            true,
+			},
+			"accounts": schema.ListNestedAttribute{
+				MarkdownDescription: "Accounts, in the order returned by the API",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Account identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Account name",
+							Computed:            true,
+						},
+						"access_levels": schema.ListAttribute{
+							MarkdownDescription: "Access levels of the API token on the account",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+						"roles": schema.ListAttribute{
+							MarkdownDescription: "Role names of `access_levels`: `owner` (1000), `admin` (100), `viewer` (10) or `unknown`",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *AccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *AccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
+	// Get all accounts
+	accounts, err := d.client.Accounts.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read accounts, got error: %s", err))
+		return
+	}
+
+	data.Accounts = []AccountsAccountModel{}
+	for _, account := range accounts {
+		if nameRegex != nil && !nameRegex.MatchString(account.Name) {
+			continue
+		}
+
+		accessLevels, roles, diags := convertAccessLevelsToTerraform(ctx, account.AccessLevels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Accounts = append(data.Accounts, AccountsAccountModel{
+			ID:           types.Int64Value(int64(account.ID)),
+			Name:         types.StringValue(account.Name),
+			AccessLevels: accessLevels,
+			Roles:        roles,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
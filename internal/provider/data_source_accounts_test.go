@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
+)
+
+func TestAccountsDataSource_Metadata(t *testing.T) {
+	d := &AccountsDataSource{}
+
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "mailtrap"}, resp)
+
+	expected := "mailtrap_accounts"
+	if resp.TypeName != expected {
+		t.Errorf("Expected type name %s, got %s", expected, resp.TypeName)
+	}
+}
+
+func TestAccessLevelRole(t *testing.T) {
+	tests := []struct {
+		level int
+		role  string
+	}{
+		{1000, "owner"},
+		{100, "admin"},
+		{10, "viewer"},
+		{1, "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := accessLevelRole(tt.level); got != tt.role {
+			t.Errorf("Expected role %s for access level %d, got %s", tt.role, tt.level, got)
+		}
+	}
+}
+
+func TestAccountsDataSource_Read(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	production := server.AddAccount("Production")
+	staging := server.AddAccount("Staging", fakemailtrap.AccessAdmin, 10)
+	server.AddAccount("Sandbox")
+
+	d := &AccountsDataSource{client: testServerClient(server)}
+	resp := testDataSourceRead(t, d, AccountsDataSourceModel{
+		NameRegex: types.StringValue("^(Production|Staging)$"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data AccountsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Failed to read state: %v", resp.Diagnostics.Errors())
+	}
+
+	if len(data.Accounts) != 2 {
+		t.Fatalf("Expected 2 accounts, got %d", len(data.Accounts))
+	}
+	if data.Accounts[0].ID.ValueInt64() != int64(production.ID) || data.Accounts[1].ID.ValueInt64() != int64(staging.ID) {
+		t.Errorf("Expected accounts %d and %d, got %v", production.ID, staging.ID, data.Accounts)
+	}
+
+	var roles []string
+	resp.Diagnostics.Append(data.Accounts[1].Roles.ElementsAs(context.Background(), &roles, false)...)
+	if len(roles) != 2 || roles[0] != "admin" || roles[1] != "viewer" {
+		t.Errorf("Expected roles [admin viewer], got %v", roles)
+	}
+}
+
+func TestAccountsDataSource_Read_InvalidRegex(t *testing.T) {
+	d := &AccountsDataSource{}
+	resp := testDataSourceRead(t, d, AccountsDataSourceModel{
+		NameRegex: types.StringValue("("),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected error for an invalid name_regex")
+	}
+}
+
+func TestAccAccountsDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	other := server.AddAccount("Other Account", fakemailtrap.AccessAdmin)
+	dataSourceName := "data.mailtrap_accounts.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + `
+data "mailtrap_accounts" "test" {}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.#", "2"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.0.name", account.Name),
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.0.roles.0", "owner"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server, account.ID) + `
+data "mailtrap_accounts" "test" {
+  name_regex = "^Other"
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.0.id", fmt.Sprint(other.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.0.access_levels.0", "100"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "accounts.0.roles.0", "admin"),
+				),
+			},
+		},
+	})
+}
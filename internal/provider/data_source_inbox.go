@@ -21,6 +21,7 @@ func NewInboxDataSource() datasource.DataSource {
 type InboxDataSource struct {
 	client    *client.Client
 	accountID int64
+	accounts  []client.Account
 }
 
 // InboxDataSourceModel describes the data source data model.
@@ -152,6 +153,7 @@ func (d *InboxDataSource) Configure(ctx context.Context, req datasource.Configur
 
 	d.client = providerData.Client
 	d.accountID = providerData.AccountID
+	d.accounts = providerData.Accounts
 }
 
 func (d *InboxDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -173,16 +175,13 @@ func (d *InboxDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if accountID == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Account ID",
-			"Account ID must be provided either in the data source configuration or provider configuration",
+			missingAccountIDDetail("data source", d.accounts),
 		)
 		return
 	}
 
 	// Get inbox
-	endpoint := fmt.Sprintf("/api/accounts/%d/inboxes/%d", accountID, data.ID.ValueInt64())
-	
-	var inbox client.Inbox
-	err := d.client.Get(endpoint, &inbox)
+	inbox, err := d.client.Inboxes.Get(ctx, accountID, data.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inbox, got error: %s", err))
 		return
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInboxDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	project := server.AddProject(account.ID, "Existing Project")
+	inbox := server.AddInbox(account.ID, project.ID, "Existing Inbox")
+	dataSourceName := "data.mailtrap_inbox.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + fmt.Sprintf(`
+data "mailtrap_inbox" "test" {
+  id = %d
+}
+`, inbox.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "name", "Existing Inbox"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "project_id", fmt.Sprint(project.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "username", inbox.Username),
+					tfresource.TestCheckResourceAttr(dataSourceName, "password", inbox.Password),
+					tfresource.TestCheckResourceAttr(dataSourceName, "smtp_ports.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccInboxDataSource_FromResource reads an inbox created in the same
+// configuration, as users do to wire credentials into other providers.
+func TestAccInboxDataSource_FromResource(t *testing.T) {
+	server, account := testAccServer(t)
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccInboxResourceConfig(server, account.ID, "Acceptance Inbox", "") + `
+data "mailtrap_inbox" "test" {
+  id = mailtrap_inbox.test.id
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttrPair("data.mailtrap_inbox.test", "password", "mailtrap_inbox.test", "password"),
+					tfresource.TestCheckResourceAttrPair("data.mailtrap_inbox.test", "email_username", "mailtrap_inbox.test", "email_username"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InboxesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &InboxesDataSource{}
+
+func NewInboxesDataSource() datasource.DataSource {
+	return &InboxesDataSource{}
+}
+
+// InboxesDataSource defines the data source implementation.
+type InboxesDataSource struct {
+	client    *client.Client
+	accountID int64
+	accounts  []client.Account
+}
+
+// InboxesDataSourceModel describes the data source data model.
+type InboxesDataSourceModel struct {
+	AccountID types.Int64         `tfsdk:"account_id"`
+	ProjectID types.Int64         `tfsdk:"project_id"`
+	NameRegex types.String        `tfsdk:"name_regex"`
+	Status    types.String        `tfsdk:"status"`
+	Inboxes   []InboxesInboxModel `tfsdk:"inboxes"`
+}
+
+// InboxesInboxModel describes one inbox of the inboxes data source.
+type InboxesInboxModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	ProjectID               types.Int64  `tfsdk:"project_id"`
+	Name                    types.String `tfsdk:"name"`
+	Username                types.String `tfsdk:"username"`
+	Password                types.String `tfsdk:"password"`
+	EmailUsername           types.String `tfsdk:"email_username"`
+	EmailUsernameEnabled    types.Bool   `tfsdk:"email_username_enabled"`
+	Domain                  types.String `tfsdk:"domain"`
+	EmailDomain             types.String `tfsdk:"email_domain"`
+	POP3Domain              types.String `tfsdk:"pop3_domain"`
+	SMTPPorts               types.List   `tfsdk:"smtp_ports"`
+	POP3Ports               types.List   `tfsdk:"pop3_ports"`
+	Status                  types.String `tfsdk:"status"`
+	MaxSize                 types.Int64  `tfsdk:"max_size"`
+	SentMessagesCount       types.Int64  `tfsdk:"sent_messages_count"`
+	ForwardedMessagesCount  types.Int64  `tfsdk:"forwarded_messages_count"`
+	ForwardFromEmailAddress types.String `tfsdk:"forward_from_email_address"`
+}
+
+func (d *InboxesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inboxes"
+}
+
+func (d *InboxesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the inboxes of an account, including their SMTP credentials",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				MarkdownDescription: "Account ID for the inboxes",
+				Optional:            true,
+				Computed:            true,
+			},
+			"project_id": schema.Int64Attribute{
+				MarkdownDescription: "Only return inboxes of this project",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) the inbox name must match",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return inboxes with this status, such as `active`",
+				Optional:            true,
+			},
+			"inboxes": schema.ListNestedAttribute{
+				MarkdownDescription: "Inboxes matching every filter, in the order returned by the API",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Inbox identifier",
+							Computed:            true,
+						},
+						"project_id": schema.Int64Attribute{
+							MarkdownDescription: "Project ID for the inbox",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Inbox name",
+							Computed:            true,
+						},
+						"username": schema.StringAttribute{
+							MarkdownDescription: "SMTP username for the inbox",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"password": schema.StringAttribute{
+							MarkdownDescription: "SMTP password for the inbox",
+							Computed:            true,
+							Sensitive:           true,
+						},
+						"email_username": schema.StringAttribute{
+							MarkdownDescription: "Email username part (before @) for the inbox email address",
+							Computed:            true,
+						},
+						"email_username_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether email username is enabled",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain for SMTP",
+							Computed:            true,
+						},
+						"email_domain": schema.StringAttribute{
+							MarkdownDescription: "Email domain",
+							Computed:            true,
+						},
+						"pop3_domain": schema.StringAttribute{
+							MarkdownDescription: "POP3 domain",
+							Computed:            true,
+						},
+						"smtp_ports": schema.ListAttribute{
+							MarkdownDescription: "Available SMTP ports",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"pop3_ports": schema.ListAttribute{
+							MarkdownDescription: "Available POP3 ports",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Inbox status",
+							Computed:            true,
+						},
+						"max_size": schema.Int64Attribute{
+							MarkdownDescription: "Maximum inbox size",
+							Computed:            true,
+						},
+						"sent_messages_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of sent messages",
+							Computed:            true,
+						},
+						"forwarded_messages_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of forwarded messages",
+							Computed:            true,
+						},
+						"forward_from_email_address": schema.StringAttribute{
+							MarkdownDescription: "Email address used for forwarding",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InboxesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *InboxesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.accountID = providerData.AccountID
+	d.accounts = providerData.Accounts
+}
+
+func (d *InboxesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InboxesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
+	// Determine account ID
+	accountID := d.accountID
+	if !data.AccountID.IsNull() && !data.AccountID.IsUnknown() {
+		accountID = data.AccountID.ValueInt64()
+	}
+
+	if accountID == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Account ID",
+			missingAccountIDDetail("data source", d.accounts),
+		)
+		return
+	}
+
+	// Get all inboxes
+	inboxes, err := d.client.Inboxes.List(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inboxes, got error: %s", err))
+		return
+	}
+
+	data.AccountID = types.Int64Value(accountID)
+	data.Inboxes = []InboxesInboxModel{}
+	for _, inbox := range inboxes {
+		if !data.ProjectID.IsNull() && int64(inbox.ProjectID) != data.ProjectID.ValueInt64() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(inbox.Name) {
+			continue
+		}
+		if !data.Status.IsNull() && inbox.Status != data.Status.ValueString() {
+			continue
+		}
+
+		smtpPorts, diags := convertPortsToTerraform(ctx, inbox.SMTPPorts)
+		resp.Diagnostics.Append(diags...)
+		pop3Ports, diags := convertPortsToTerraform(ctx, inbox.POP3Ports)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Inboxes = append(data.Inboxes, InboxesInboxModel{
+			ID:                      types.Int64Value(int64(inbox.ID)),
+			ProjectID:               types.Int64Value(int64(inbox.ProjectID)),
+			Name:                    types.StringValue(inbox.Name),
+			Username:                types.StringValue(inbox.Username),
+			Password:                types.StringValue(inbox.Password),
+			EmailUsername:           types.StringValue(inbox.EmailUsername),
+			EmailUsernameEnabled:    types.BoolValue(inbox.EmailUsernameEnabled),
+			Domain:                  types.StringValue(inbox.Domain),
+			EmailDomain:             types.StringValue(inbox.EmailDomain),
+			POP3Domain:              types.StringValue(inbox.POP3Domain),
+			SMTPPorts:               smtpPorts,
+			POP3Ports:               pop3Ports,
+			Status:                  types.StringValue(inbox.Status),
+			MaxSize:                 types.Int64Value(int64(inbox.MaxSize)),
+			SentMessagesCount:       types.Int64Value(int64(inbox.SentMessagesCount)),
+			ForwardedMessagesCount:  types.Int64Value(int64(inbox.ForwardedMessagesCount)),
+			ForwardFromEmailAddress: types.StringValue(inbox.ForwardFromEmailAddress),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertPortsToTerraform returns SMTP or POP3 ports as a Terraform list.
+func convertPortsToTerraform(ctx context.Context, ports []int) (types.List, diag.Diagnostics) {
+	values := make([]int64, len(ports))
+	for i, port := range ports {
+		values[i] = int64(port)
+	}
+	return types.ListValueFrom(ctx, types.Int64Type, values)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
+)
+
+func TestInboxesDataSource_Metadata(t *testing.T) {
+	d := &InboxesDataSource{}
+
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "mailtrap"}, resp)
+
+	expected := "mailtrap_inboxes"
+	if resp.TypeName != expected {
+		t.Errorf("Expected type name %s, got %s", expected, resp.TypeName)
+	}
+}
+
+func TestInboxesDataSource_Schema_SensitiveCredentials(t *testing.T) {
+	d := &InboxesDataSource{}
+
+	resp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes["inboxes"].(schema.ListNestedAttribute).NestedObject.Attributes
+	for _, name := range []string{"username", "password"} {
+		if !attrs[name].IsSensitive() {
+			t.Errorf("Expected inboxes.%s to be sensitive", name)
+		}
+	}
+}
+
+func TestInboxesDataSource_Read(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	staging := server.AddProject(account.ID, "Staging")
+	production := server.AddProject(account.ID, "Production")
+	qa := server.AddInbox(account.ID, staging.ID, "QA")
+	qaSignup := server.AddInbox(account.ID, staging.ID, "QA Signup")
+	prodQA := server.AddInbox(account.ID, production.ID, "QA")
+	server.UpdateInbox(qaSignup.ID, func(inbox *client.Inbox) {
+		inbox.Status = "disabled"
+	})
+
+	d := &InboxesDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+
+	tests := []struct {
+		name     string
+		model    InboxesDataSourceModel
+		expected []int
+	}{
+		{
+			name:     "no filters",
+			model:    InboxesDataSourceModel{},
+			expected: []int{qa.ID, qaSignup.ID, prodQA.ID},
+		},
+		{
+			name:     "project_id",
+			model:    InboxesDataSourceModel{ProjectID: types.Int64Value(int64(staging.ID))},
+			expected: []int{qa.ID, qaSignup.ID},
+		},
+		{
+			name:     "name_regex",
+			model:    InboxesDataSourceModel{NameRegex: types.StringValue("^QA$")},
+			expected: []int{qa.ID, prodQA.ID},
+		},
+		{
+			name: "status",
+			model: InboxesDataSourceModel{
+				ProjectID: types.Int64Value(int64(staging.ID)),
+				Status:    types.StringValue("disabled"),
+			},
+			expected: []int{qaSignup.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := testDataSourceRead(t, d, tt.model)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+			}
+
+			var data InboxesDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if len(data.Inboxes) != len(tt.expected) {
+				t.Fatalf("Expected %d inboxes, got %d", len(tt.expected), len(data.Inboxes))
+			}
+			for i, id := range tt.expected {
+				if data.Inboxes[i].ID.ValueInt64() != int64(id) {
+					t.Errorf("Expected inbox %d at index %d, got %d", id, i, data.Inboxes[i].ID.ValueInt64())
+				}
+			}
+		})
+	}
+}
+
+func TestInboxesDataSource_Read_Attributes(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	project := server.AddProject(account.ID, "Staging")
+	inbox := server.AddInbox(account.ID, project.ID, "QA")
+
+	d := &InboxesDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+	resp := testDataSourceRead(t, d, InboxesDataSourceModel{})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data InboxesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if len(data.Inboxes) != 1 {
+		t.Fatalf("Expected 1 inbox, got %d", len(data.Inboxes))
+	}
+
+	got := data.Inboxes[0]
+	if got.ProjectID.ValueInt64() != int64(project.ID) {
+		t.Errorf("Expected project ID %d, got %d", project.ID, got.ProjectID.ValueInt64())
+	}
+	if got.Username.ValueString() != inbox.Username || got.Password.ValueString() != inbox.Password {
+		t.Errorf("Expected the SMTP credentials of inbox %d", inbox.ID)
+	}
+	if len(got.SMTPPorts.Elements()) != len(inbox.SMTPPorts) {
+		t.Errorf("Expected %d SMTP ports, got %d", len(inbox.SMTPPorts), len(got.SMTPPorts.Elements()))
+	}
+}
+
+func TestAccInboxesDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	project := server.AddProject(account.ID, "Staging")
+	qa := server.AddInbox(account.ID, project.ID, "QA")
+	server.AddInbox(account.ID, project.ID, "Marketing")
+	dataSourceName := "data.mailtrap_inboxes.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + fmt.Sprintf(`
+data "mailtrap_inboxes" "test" {
+  project_id = %d
+  name_regex = "^QA"
+}
+`, project.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "inboxes.#", "1"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "inboxes.0.id", fmt.Sprint(qa.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "inboxes.0.password", qa.Password),
+					tfresource.TestCheckResourceAttr(dataSourceName, "inboxes.0.smtp_ports.#", "4"),
+				),
+			},
+		},
+	})
+}
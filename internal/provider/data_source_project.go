@@ -3,16 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ProjectDataSource{}
 
 func NewProjectDataSource() datasource.DataSource {
 	return &ProjectDataSource{}
@@ -22,6 +25,7 @@ func NewProjectDataSource() datasource.DataSource {
 type ProjectDataSource struct {
 	client    *client.Client
 	accountID int64
+	accounts  []client.Account
 }
 
 // ProjectDataSourceModel describes the data source data model.
@@ -43,8 +47,9 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Project identifier",
-				Required:            true,
+				MarkdownDescription: "Project identifier. Exactly one of `id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"account_id": schema.Int64Attribute{
 				MarkdownDescription: "Account ID for the project",
@@ -52,7 +57,8 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Project name",
+				MarkdownDescription: "Project name. Exactly one of `id` and `name` must be set; the name must match a single project of the account.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"share_links": schema.SingleNestedAttribute{
@@ -73,6 +79,24 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	}
 }
 
+func (d *ProjectDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Project Lookup",
+			"Exactly one of id and name must be set to look up a project.",
+		)
+	}
+}
+
 func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -90,6 +114,7 @@ func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.Config
 
 	d.client = providerData.Client
 	d.accountID = providerData.AccountID
+	d.accounts = providerData.Accounts
 }
 
 func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -111,22 +136,56 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if accountID == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Account ID",
-			"Account ID must be provided either in the data source configuration or provider configuration",
+			missingAccountIDDetail("data source", d.accounts),
 		)
 		return
 	}
 
-	// Get project
-	endpoint := fmt.Sprintf("/api/accounts/%d/projects/%d", accountID, data.ID.ValueInt64())
-	
-	var project client.Project
-	err := d.client.Get(endpoint, &project)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
-		return
+	var project *client.Project
+	if !data.ID.IsNull() {
+		// Get project
+		var err error
+		project, err = d.client.Projects.Get(ctx, accountID, data.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+			return
+		}
+	} else {
+		// Find the project with matching name
+		projects, err := d.client.Projects.List(ctx, accountID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read projects, got error: %s", err))
+			return
+		}
+
+		name := data.Name.ValueString()
+		var matches []client.Project
+		for _, p := range projects {
+			if p.Name == name {
+				matches = append(matches, p)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Project Not Found",
+				fmt.Sprintf("Project named %q not found in account %d", name, accountID),
+			)
+			return
+		case 1:
+			project = &matches[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple Projects Found",
+				fmt.Sprintf("Several projects of account %d are named %q: %s. Look the project up by id instead.", accountID, name, describeProjects(matches)),
+			)
+			return
+		}
 	}
 
 	// Update model with response data
+	data.ID = types.Int64Value(int64(project.ID))
 	data.AccountID = types.Int64Value(accountID)
 	data.Name = types.StringValue(project.Name)
 
@@ -148,3 +207,12 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// describeProjects lists projects as "ID (name)" for diagnostics.
+func describeProjects(projects []client.Project) string {
+	descriptions := make([]string, len(projects))
+	for i, project := range projects {
+		descriptions[i] = fmt.Sprintf("%d (%s)", project.ID, project.Name)
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
 )
 
 func TestProjectDataSource_Metadata(t *testing.T) {
@@ -173,4 +177,99 @@ func TestProjectDataSourceModel(t *testing.T) {
 	if model.ShareLinks.IsNull() {
 		t.Error("Expected share links to be set")
 	}
-}
\ No newline at end of file
+}
+
+func TestAccProjectDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	project := server.AddProject(account.ID, "Existing Project")
+	dataSourceName := "data.mailtrap_project.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + fmt.Sprintf(`
+data "mailtrap_project" "test" {
+  id = %d
+}
+`, project.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "name", "Existing Project"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "account_id", fmt.Sprint(account.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "share_links.admin", project.ShareLinks.Admin),
+				),
+			},
+		},
+	})
+}
+
+func TestProjectDataSource_Read_ByName(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	server.AddProject(account.ID, "Other")
+	staging := server.AddProject(account.ID, "Staging")
+	server.AddProject(account.ID, "Duplicate")
+	server.AddProject(account.ID, "Duplicate")
+
+	d := &ProjectDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+	model := ProjectDataSourceModel{
+		ID:        types.Int64Null(),
+		AccountID: types.Int64Null(),
+		Name:      types.StringValue("Staging"),
+		ShareLinks: types.ObjectNull(map[string]attr.Type{
+			"admin":  types.StringType,
+			"viewer": types.StringType,
+		}),
+	}
+
+	resp := testDataSourceRead(t, d, model)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data ProjectDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.ID.ValueInt64() != int64(staging.ID) {
+		t.Errorf("Expected ID %d, got %d", staging.ID, data.ID.ValueInt64())
+	}
+
+	tests := []struct {
+		name   string
+		detail string
+	}{
+		{"Duplicate", "Several projects"},
+		{"Missing", "not found"},
+	}
+	for _, tt := range tests {
+		model.Name = types.StringValue(tt.name)
+		resp := testDataSourceRead(t, d, model)
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("Expected error for project name %s", tt.name)
+		}
+		if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tt.detail) {
+			t.Errorf("Expected the detail to contain %q, got %s", tt.detail, detail)
+		}
+	}
+}
+
+func TestAccProjectDataSource_ByName(t *testing.T) {
+	server, account := testAccServer(t)
+	project := server.AddProject(account.ID, "Existing Project")
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + `
+data "mailtrap_project" "test" {
+  name = "Existing Project"
+}
+`,
+				Check: tfresource.TestCheckResourceAttr("data.mailtrap_project.test", "id", fmt.Sprint(project.ID)),
+			},
+		},
+	})
+}
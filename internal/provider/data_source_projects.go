@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client    *client.Client
+	accountID int64
+	accounts  []client.Account
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	AccountID     types.Int64            `tfsdk:"account_id"`
+	Name          types.String           `tfsdk:"name"`
+	NameRegex     types.String           `tfsdk:"name_regex"`
+	HasInboxNamed types.String           `tfsdk:"has_inbox_named"`
+	Projects      []ProjectsProjectModel `tfsdk:"projects"`
+}
+
+// ProjectsProjectModel describes one project of the projects data source.
+type ProjectsProjectModel struct {
+	ID         types.Int64          `tfsdk:"id"`
+	Name       types.String         `tfsdk:"name"`
+	ShareLinks ShareLinksModel      `tfsdk:"share_links"`
+	Inboxes    []ProjectsInboxModel `tfsdk:"inboxes"`
+}
+
+// ProjectsInboxModel summarizes an inbox of a project.
+type ProjectsInboxModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Status        types.String `tfsdk:"status"`
+	EmailUsername types.String `tfsdk:"email_username"`
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the projects of an account",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				MarkdownDescription: "Account ID for the projects",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Exact project name to match",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) the project name must match",
+				Optional:            true,
+			},
+			"has_inbox_named": schema.StringAttribute{
+				MarkdownDescription: "Only return projects holding an inbox with exactly this name",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects matching every filter, in the order returned by the API",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Project identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Project name",
+							Computed:            true,
+						},
+						"share_links": schema.SingleNestedAttribute{
+							MarkdownDescription: "Share links for the project",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"admin": schema.StringAttribute{
+									MarkdownDescription: "Admin share link",
+									Computed:            true,
+								},
+								"viewer": schema.StringAttribute{
+									MarkdownDescription: "Viewer share link",
+									Computed:            true,
+								},
+							},
+						},
+						"inboxes": schema.ListNestedAttribute{
+							MarkdownDescription: "Inboxes of the project. Use the `mailtrap_inbox` data source for their credentials.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										MarkdownDescription: "Inbox identifier",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Inbox name",
+										Computed:            true,
+									},
+									"status": schema.StringAttribute{
+										MarkdownDescription: "Inbox status",
+										Computed:            true,
+									},
+									"email_username": schema.StringAttribute{
+										MarkdownDescription: "Email username of the inbox",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var nameRegex types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_regex"), &nameRegex)...)
+
+	if resp.Diagnostics.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+		)
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.accountID = providerData.AccountID
+	d.accounts = providerData.Accounts
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("name_regex is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
+	// Determine account ID
+	accountID := d.accountID
+	if !data.AccountID.IsNull() && !data.AccountID.IsUnknown() {
+		accountID = data.AccountID.ValueInt64()
+	}
+
+	if accountID == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Account ID",
+			missingAccountIDDetail("data source", d.accounts),
+		)
+		return
+	}
+
+	// Get all projects
+	projects, err := d.client.Projects.List(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read projects, got error: %s", err))
+		return
+	}
+
+	data.AccountID = types.Int64Value(accountID)
+	data.Projects = []ProjectsProjectModel{}
+	for _, project := range projects {
+		if !data.Name.IsNull() && project.Name != data.Name.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(project.Name) {
+			continue
+		}
+		if !data.HasInboxNamed.IsNull() && !projectHasInboxNamed(project, data.HasInboxNamed.ValueString()) {
+			continue
+		}
+
+		inboxes := make([]ProjectsInboxModel, len(project.Inboxes))
+		for i, inbox := range project.Inboxes {
+			inboxes[i] = ProjectsInboxModel{
+				ID:            types.Int64Value(int64(inbox.ID)),
+				Name:          types.StringValue(inbox.Name),
+				Status:        types.StringValue(inbox.Status),
+				EmailUsername: types.StringValue(inbox.EmailUsername),
+			}
+		}
+
+		data.Projects = append(data.Projects, ProjectsProjectModel{
+			ID:   types.Int64Value(int64(project.ID)),
+			Name: types.StringValue(project.Name),
+			ShareLinks: ShareLinksModel{
+				Admin:  types.StringValue(project.ShareLinks.Admin),
+				Viewer: types.StringValue(project.ShareLinks.Viewer),
+			},
+			Inboxes: inboxes,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// projectHasInboxNamed reports whether the project holds an inbox with the
+// given name.
+func projectHasInboxNamed(project client.Project, name string) bool {
+	for _, inbox := range project.Inboxes {
+		if inbox.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
+)
+
+func TestProjectsDataSource_Metadata(t *testing.T) {
+	d := &ProjectsDataSource{}
+
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "mailtrap"}, resp)
+
+	expected := "mailtrap_projects"
+	if resp.TypeName != expected {
+		t.Errorf("Expected type name %s, got %s", expected, resp.TypeName)
+	}
+}
+
+func TestProjectsDataSource_Read(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	staging := server.AddProject(account.ID, "Staging")
+	server.AddInbox(account.ID, staging.ID, "QA")
+	stagingEU := server.AddProject(account.ID, "Staging EU")
+	server.AddInbox(account.ID, stagingEU.ID, "Signup")
+	production := server.AddProject(account.ID, "Production")
+
+	d := &ProjectsDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+
+	tests := []struct {
+		name     string
+		model    ProjectsDataSourceModel
+		expected []int
+	}{
+		{
+			name:     "no filters",
+			model:    ProjectsDataSourceModel{},
+			expected: []int{staging.ID, stagingEU.ID, production.ID},
+		},
+		{
+			name:     "name",
+			model:    ProjectsDataSourceModel{Name: types.StringValue("Staging")},
+			expected: []int{staging.ID},
+		},
+		{
+			name:     "name_regex",
+			model:    ProjectsDataSourceModel{NameRegex: types.StringValue("^Staging")},
+			expected: []int{staging.ID, stagingEU.ID},
+		},
+		{
+			name: "has_inbox_named",
+			model: ProjectsDataSourceModel{
+				NameRegex:     types.StringValue("^Staging"),
+				HasInboxNamed: types.StringValue("Signup"),
+			},
+			expected: []int{stagingEU.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := testDataSourceRead(t, d, tt.model)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+			}
+
+			var data ProjectsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if len(data.Projects) != len(tt.expected) {
+				t.Fatalf("Expected %d projects, got %d", len(tt.expected), len(data.Projects))
+			}
+			for i, id := range tt.expected {
+				if data.Projects[i].ID.ValueInt64() != int64(id) {
+					t.Errorf("Expected project %d at index %d, got %d", id, i, data.Projects[i].ID.ValueInt64())
+				}
+			}
+			if data.AccountID.ValueInt64() != int64(account.ID) {
+				t.Errorf("Expected account ID %d, got %d", account.ID, data.AccountID.ValueInt64())
+			}
+		})
+	}
+}
+
+func TestProjectsDataSource_Read_Inboxes(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	project := server.AddProject(account.ID, "Staging")
+	inbox := server.AddInbox(account.ID, project.ID, "QA")
+
+	d := &ProjectsDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+	resp := testDataSourceRead(t, d, ProjectsDataSourceModel{})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data ProjectsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if len(data.Projects) != 1 || len(data.Projects[0].Inboxes) != 1 {
+		t.Fatalf("Expected one project with one inbox, got %v", data.Projects)
+	}
+
+	got := data.Projects[0]
+	if got.ShareLinks.Admin.ValueString() != project.ShareLinks.Admin {
+		t.Errorf("Expected admin share link %s, got %s", project.ShareLinks.Admin, got.ShareLinks.Admin.ValueString())
+	}
+	if got.Inboxes[0].ID.ValueInt64() != int64(inbox.ID) || got.Inboxes[0].EmailUsername.ValueString() != inbox.EmailUsername {
+		t.Errorf("Expected inbox %d (%s), got %v", inbox.ID, inbox.EmailUsername, got.Inboxes[0])
+	}
+}
+
+func TestProjectsDataSource_Read_MissingAccountID(t *testing.T) {
+	d := &ProjectsDataSource{accounts: []client.Account{{ID: 1, Name: "Production"}, {ID: 2, Name: "Staging"}}}
+	resp := testDataSourceRead(t, d, ProjectsDataSourceModel{})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error without an account ID")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "1 (Production), 2 (Staging)") {
+		t.Errorf("Expected the error to list the candidate accounts, got %s", detail)
+	}
+}
+
+func TestAccProjectsDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	project := server.AddProject(account.ID, "Staging")
+	server.AddInbox(account.ID, project.ID, "QA")
+	server.AddProject(account.ID, "Production")
+	dataSourceName := "data.mailtrap_projects.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + `
+data "mailtrap_projects" "test" {
+  has_inbox_named = "QA"
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "projects.#", "1"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "projects.0.id", fmt.Sprint(project.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "projects.0.inboxes.0.name", "QA"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "projects.0.share_links.viewer", project.ShareLinks.Viewer),
+				),
+			},
+		},
+	})
+}
@@ -3,17 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &SendingDomainDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &SendingDomainDataSource{}
 
 func NewSendingDomainDataSource() datasource.DataSource {
 	return &SendingDomainDataSource{}
@@ -23,6 +26,7 @@ func NewSendingDomainDataSource() datasource.DataSource {
 type SendingDomainDataSource struct {
 	client    *client.Client
 	accountID int64
+	accounts  []client.Account
 }
 
 // SendingDomainDataSourceModel describes the data source data model.
@@ -49,8 +53,9 @@ func (d *SendingDomainDataSource) Schema(ctx context.Context, req datasource.Sch
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Sending domain identifier",
-				Required:            true,
+				MarkdownDescription: "Sending domain identifier. Exactly one of `id` and `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"account_id": schema.Int64Attribute{
 				MarkdownDescription: "Account ID for the sending domain",
@@ -58,7 +63,8 @@ func (d *SendingDomainDataSource) Schema(ctx context.Context, req datasource.Sch
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Domain name",
+				MarkdownDescription: "Domain name. Exactly one of `id` and `name` must be set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"cname": schema.StringAttribute{
@@ -73,118 +79,32 @@ func (d *SendingDomainDataSource) Schema(ctx context.Context, req datasource.Sch
 				MarkdownDescription: "Compliance status",
 				Computed:            true,
 			},
-			"dns_records": schema.SingleNestedAttribute{
-				MarkdownDescription: "DNS records for domain verification",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"cname": schema.ListNestedAttribute{
-						MarkdownDescription: "CNAME records",
-						Computed:            true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"priority": schema.Int64Attribute{
-									MarkdownDescription: "Record priority",
-									Computed:            true,
-								},
-								"record_type": schema.StringAttribute{
-									MarkdownDescription: "Record type",
-									Computed:            true,
-								},
-								"hostname": schema.StringAttribute{
-									MarkdownDescription: "Hostname",
-									Computed:            true,
-								},
-								"value": schema.StringAttribute{
-									MarkdownDescription: "Record value",
-									Computed:            true,
-								},
-								"status": schema.StringAttribute{
-									MarkdownDescription: "Record status",
-									Computed:            true,
-								},
-							},
-						},
-					},
-					"mx": schema.ListNestedAttribute{
-						MarkdownDescription: "MX records",
-						Computed:            true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"priority": schema.Int64Attribute{
-									MarkdownDescription: "Record priority",
-									Computed:            true,
-								},
-								"record_type": schema.StringAttribute{
-									MarkdownDescription: "Record type",
-									Computed:            true,
-								},
-								"hostname": schema.StringAttribute{
-									MarkdownDescription: "Hostname",
-									Computed:            true,
-								},
-								"value": schema.StringAttribute{
-									MarkdownDescription: "Record value",
-									Computed:            true,
-								},
-								"status": schema.StringAttribute{
-									MarkdownDescription: "Record status",
-									Computed:            true,
-								},
-							},
-						},
-					},
-					"txt": schema.ListNestedAttribute{
-						MarkdownDescription: "TXT records",
-						Computed:            true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"priority": schema.Int64Attribute{
-									MarkdownDescription: "Record priority",
-									Computed:            true,
-								},
-								"record_type": schema.StringAttribute{
-									MarkdownDescription: "Record type",
-									Computed:            true,
-								},
-								"hostname": schema.StringAttribute{
-									MarkdownDescription: "Hostname",
-									Computed:            true,
-								},
-								"value": schema.StringAttribute{
-									MarkdownDescription: "Record value",
-									Computed:            true,
-								},
-								"status": schema.StringAttribute{
-									MarkdownDescription: "Record status",
-									Computed:            true,
-								},
-							},
-						},
-					},
-				},
-			},
-			"dns_status": schema.SingleNestedAttribute{
-				MarkdownDescription: "DNS verification status",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"cname": schema.BoolAttribute{
-						MarkdownDescription: "CNAME verification status",
-						Computed:            true,
-					},
-					"mx": schema.BoolAttribute{
-						MarkdownDescription: "MX verification status",
-						Computed:            true,
-					},
-					"txt": schema.BoolAttribute{
-						MarkdownDescription: "TXT verification status",
-						Computed:            true,
-					},
-				},
-			},
+			"dns_records": sendingDomainDNSRecordsSchema(),
+			"dns_status":  sendingDomainDNSStatusSchema(),
 		},
 	}
 }
 
+func (d *SendingDomainDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id types.Int64
+	var name types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if resp.Diagnostics.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Sending Domain Lookup",
+			"Exactly one of id and name must be set to look up a sending domain.",
+		)
+	}
+}
+
 func (d *SendingDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -202,6 +122,7 @@ func (d *SendingDomainDataSource) Configure(ctx context.Context, req datasource.
 
 	d.client = providerData.Client
 	d.accountID = providerData.AccountID
+	d.accounts = providerData.Accounts
 }
 
 func (d *SendingDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -223,22 +144,56 @@ func (d *SendingDomainDataSource) Read(ctx context.Context, req datasource.ReadR
 	if accountID == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Account ID",
-			"Account ID must be provided either in the data source configuration or provider configuration",
+			missingAccountIDDetail("data source", d.accounts),
 		)
 		return
 	}
 
-	// Get sending domain
-	endpoint := fmt.Sprintf("/api/accounts/%d/sending_domains/%d", accountID, data.ID.ValueInt64())
-	
-	var domain client.SendingDomain
-	err := d.client.Get(endpoint, &domain)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sending domain, got error: %s", err))
-		return
+	var domain *client.SendingDomain
+	if !data.ID.IsNull() {
+		// Get sending domain
+		var err error
+		domain, err = d.client.SendingDomains.Get(ctx, accountID, data.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sending domain, got error: %s", err))
+			return
+		}
+	} else {
+		// Find the sending domain with matching name
+		domains, err := d.client.SendingDomains.List(ctx, accountID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sending domains, got error: %s", err))
+			return
+		}
+
+		name := data.Name.ValueString()
+		var matches []client.SendingDomain
+		for _, sd := range domains {
+			if strings.EqualFold(sd.Name, name) {
+				matches = append(matches, sd)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			resp.Diagnostics.AddError(
+				"Sending Domain Not Found",
+				fmt.Sprintf("Sending domain %q not found in account %d", name, accountID),
+			)
+			return
+		case 1:
+			domain = &matches[0]
+		default:
+			resp.Diagnostics.AddError(
+				"Multiple Sending Domains Found",
+				fmt.Sprintf("Several sending domains of account %d match the name %q: %s. Look the sending domain up by id instead.", accountID, name, describeSendingDomains(matches)),
+			)
+			return
+		}
 	}
 
 	// Update model with response data
+	data.ID = types.Int64Value(int64(domain.ID))
 	data.AccountID = types.Int64Value(accountID)
 	data.Name = types.StringValue(domain.Name)
 	data.CNAME = types.StringValue(domain.CNAME)
@@ -246,7 +201,7 @@ func (d *SendingDomainDataSource) Read(ctx context.Context, req datasource.ReadR
 	data.ComplianceStatus = types.StringValue(domain.ComplianceStatus)
 
 	// Convert DNS records
-	dnsRecordsValue, diags := d.convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
+	dnsRecordsValue, diags := convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -254,15 +209,7 @@ func (d *SendingDomainDataSource) Read(ctx context.Context, req datasource.ReadR
 	data.DNSRecords = dnsRecordsValue
 
 	// Convert DNS status
-	dnsStatusObj, diags := types.ObjectValueFrom(ctx, map[string]attr.Type{
-		"cname": types.BoolType,
-		"mx":    types.BoolType,
-		"txt":   types.BoolType,
-	}, &DNSStatusModel{
-		CNAME: types.BoolValue(domain.DNSStatus.CNAME),
-		MX:    types.BoolValue(domain.DNSStatus.MX),
-		TXT:   types.BoolValue(domain.DNSStatus.TXT),
-	})
+	dnsStatusObj, diags := convertDNSStatusToTerraform(ctx, domain.DNSStatus)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -273,8 +220,18 @@ func (d *SendingDomainDataSource) Read(ctx context.Context, req datasource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// describeSendingDomains lists sending domains as "ID (name)" for
+// diagnostics.
+func describeSendingDomains(domains []client.SendingDomain) string {
+	descriptions := make([]string, len(domains))
+	for i, domain := range domains {
+		descriptions[i] = fmt.Sprintf("%d (%s)", domain.ID, domain.Name)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
 // Helper function to convert DNS records to Terraform types
-func (d *SendingDomainDataSource) convertDNSRecordsToTerraform(ctx context.Context, records *client.DNSRecords) (types.Object, diag.Diagnostics) {
+func convertDNSRecordsToTerraform(ctx context.Context, records *client.DNSRecords) (types.Object, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Define the attribute types for a DNS record
@@ -375,3 +332,135 @@ func (d *SendingDomainDataSource) convertDNSRecordsToTerraform(ctx context.Conte
 
 	return dnsRecordsObj, diags
 }
+
+// sendingDomainDNSRecordsSchema returns the schema of the dns_records
+// attribute filled by convertDNSRecordsToTerraform.
+func sendingDomainDNSRecordsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "DNS records for domain verification",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"cname": schema.ListNestedAttribute{
+				MarkdownDescription: "CNAME records",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Record priority",
+							Computed:            true,
+						},
+						"record_type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Record status",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"mx": schema.ListNestedAttribute{
+				MarkdownDescription: "MX records",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Record priority",
+							Computed:            true,
+						},
+						"record_type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Record status",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"txt": schema.ListNestedAttribute{
+				MarkdownDescription: "TXT records",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Record priority",
+							Computed:            true,
+						},
+						"record_type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Record status",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sendingDomainDNSStatusSchema returns the schema of the dns_status
+// attribute filled by convertDNSStatusToTerraform.
+func sendingDomainDNSStatusSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "DNS verification status",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"cname": schema.BoolAttribute{
+				MarkdownDescription: "CNAME verification status",
+				Computed:            true,
+			},
+			"mx": schema.BoolAttribute{
+				MarkdownDescription: "MX verification status",
+				Computed:            true,
+			},
+			"txt": schema.BoolAttribute{
+				MarkdownDescription: "TXT verification status",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// convertDNSStatusToTerraform converts the DNS verification status to
+// Terraform types.
+func convertDNSStatusToTerraform(ctx context.Context, status client.DNSStatus) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, map[string]attr.Type{
+		"cname": types.BoolType,
+		"mx":    types.BoolType,
+		"txt":   types.BoolType,
+	}, &DNSStatusModel{
+		CNAME: types.BoolValue(status.CNAME),
+		MX:    types.BoolValue(status.MX),
+		TXT:   types.BoolValue(status.TXT),
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
+)
+
+func TestSendingDomainDataSource_Read_ByName(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	server.AddSendingDomain(account.ID, "other.example.com")
+	domain := server.AddSendingDomain(account.ID, "mail.example.com")
+
+	d := &SendingDomainDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+	model := SendingDomainDataSourceModel{
+		Name:       types.StringValue("Mail.Example.com"),
+		DNSRecords: types.ObjectNull(sendingDomainDNSRecordsSchema().GetType().(types.ObjectType).AttrTypes),
+		DNSStatus:  types.ObjectNull(sendingDomainDNSStatusSchema().GetType().(types.ObjectType).AttrTypes),
+	}
+
+	resp := testDataSourceRead(t, d, model)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data SendingDomainDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if data.ID.ValueInt64() != int64(domain.ID) {
+		t.Errorf("Expected ID %d, got %d", domain.ID, data.ID.ValueInt64())
+	}
+	if data.Name.ValueString() != domain.Name {
+		t.Errorf("Expected name %s, got %s", domain.Name, data.Name.ValueString())
+	}
+	if data.DNSRecords.IsNull() || data.DNSStatus.IsNull() {
+		t.Error("Expected DNS records and status to be set")
+	}
+
+	server.AddSendingDomain(account.ID, "dup.example.com")
+	server.AddSendingDomain(account.ID, "DUP.example.com")
+
+	tests := []struct {
+		name   string
+		detail string
+	}{
+		{"dup.example.com", "Several sending domains"},
+		{"missing.example.com", "not found"},
+	}
+	for _, tt := range tests {
+		model.Name = types.StringValue(tt.name)
+		resp := testDataSourceRead(t, d, model)
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("Expected error for sending domain name %s", tt.name)
+		}
+		if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tt.detail) {
+			t.Errorf("Expected the detail to contain %q, got %s", tt.detail, detail)
+		}
+	}
+}
+
+func TestAccSendingDomainDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	domain := server.AddSendingDomain(account.ID, "existing.example.com")
+	dataSourceName := "data.mailtrap_sending_domain.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + fmt.Sprintf(`
+data "mailtrap_sending_domain" "test" {
+  id = %d
+}
+`, domain.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "name", "existing.example.com"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "status", "pending"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "dns_records.cname.#", "3"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "dns_records.cname.0.value", domain.DNSRecords.CNAME[0].Value),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server, account.ID) + `
+data "mailtrap_sending_domain" "test" {
+  name = "existing.example.com"
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprint(domain.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "dns_records.cname.0.value", domain.DNSRecords.CNAME[0].Value),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SendingDomainsDataSource{}
+
+func NewSendingDomainsDataSource() datasource.DataSource {
+	return &SendingDomainsDataSource{}
+}
+
+// SendingDomainsDataSource defines the data source implementation.
+type SendingDomainsDataSource struct {
+	client    *client.Client
+	accountID int64
+	accounts  []client.Account
+}
+
+// SendingDomainsDataSourceModel describes the data source data model.
+type SendingDomainsDataSourceModel struct {
+	AccountID        types.Int64                 `tfsdk:"account_id"`
+	Status           types.String                `tfsdk:"status"`
+	ComplianceStatus types.String                `tfsdk:"compliance_status"`
+	NameSuffix       types.String                `tfsdk:"name_suffix"`
+	SendingDomains   []SendingDomainsDomainModel `tfsdk:"sending_domains"`
+}
+
+// SendingDomainsDomainModel describes one sending domain of the sending
+// domains data source.
+type SendingDomainsDomainModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	CNAME            types.String `tfsdk:"cname"`
+	Status           types.String `tfsdk:"status"`
+	ComplianceStatus types.String `tfsdk:"compliance_status"`
+	DNSRecords       types.Object `tfsdk:"dns_records"`
+	DNSStatus        types.Object `tfsdk:"dns_status"`
+}
+
+func (d *SendingDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sending_domains"
+}
+
+func (d *SendingDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the sending domains of an account",
+
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.Int64Attribute{
+				MarkdownDescription: "Account ID for the sending domains",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return domains with this status, such as `pending` or `verified`",
+				Optional:            true,
+			},
+			"compliance_status": schema.StringAttribute{
+				MarkdownDescription: "Only return domains with this compliance status",
+				Optional:            true,
+			},
+			"name_suffix": schema.StringAttribute{
+				MarkdownDescription: "Only return domains that are this domain or one of its subdomains, compared case-insensitively. A leading dot is ignored, so `.example.com` and `example.com` both match `example.com` and `mail.example.com` but not `badexample.com`. An empty suffix matches every domain.",
+				Optional:            true,
+			},
+			"sending_domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Sending domains matching every filter, in the order returned by the API",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Sending domain identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Domain name",
+							Computed:            true,
+						},
+						"cname": schema.StringAttribute{
+							MarkdownDescription: "CNAME value for domain verification",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Domain status",
+							Computed:            true,
+						},
+						"compliance_status": schema.StringAttribute{
+							MarkdownDescription: "Compliance status",
+							Computed:            true,
+						},
+						"dns_records": sendingDomainDNSRecordsSchema(),
+						"dns_status":  sendingDomainDNSStatusSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SendingDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.accountID = providerData.AccountID
+	d.accounts = providerData.Accounts
+}
+
+func (d *SendingDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SendingDomainsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Determine account ID
+	accountID := d.accountID
+	if !data.AccountID.IsNull() && !data.AccountID.IsUnknown() {
+		accountID = data.AccountID.ValueInt64()
+	}
+
+	if accountID == 0 {
+		resp.Diagnostics.AddError(
+			"Missing Account ID",
+			missingAccountIDDetail("data source", d.accounts),
+		)
+		return
+	}
+
+	// Get all sending domains
+	domains, err := d.client.SendingDomains.List(ctx, accountID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sending domains, got error: %s", err))
+		return
+	}
+
+	data.AccountID = types.Int64Value(accountID)
+	data.SendingDomains = []SendingDomainsDomainModel{}
+	for _, domain := range domains {
+		if !data.Status.IsNull() && domain.Status != data.Status.ValueString() {
+			continue
+		}
+		if !data.ComplianceStatus.IsNull() && domain.ComplianceStatus != data.ComplianceStatus.ValueString() {
+			continue
+		}
+		if !data.NameSuffix.IsNull() && !domainHasSuffix(domain.Name, data.NameSuffix.ValueString()) {
+			continue
+		}
+
+		dnsRecords, diags := convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
+		resp.Diagnostics.Append(diags...)
+		dnsStatus, diags := convertDNSStatusToTerraform(ctx, domain.DNSStatus)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.SendingDomains = append(data.SendingDomains, SendingDomainsDomainModel{
+			ID:               types.Int64Value(int64(domain.ID)),
+			Name:             types.StringValue(domain.Name),
+			CNAME:            types.StringValue(domain.CNAME),
+			Status:           types.StringValue(domain.Status),
+			ComplianceStatus: types.StringValue(domain.ComplianceStatus),
+			DNSRecords:       dnsRecords,
+			DNSStatus:        dnsStatus,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// domainHasSuffix reports whether name equals suffix or is a subdomain of
+// it, ignoring case and any leading dots on suffix. The match is on label
+// boundaries, so example.com does not match badexample.com. An empty suffix
+// matches every name.
+func domainHasSuffix(name, suffix string) bool {
+	suffix = strings.TrimLeft(suffix, ".")
+	if suffix == "" {
+		return true
+	}
+
+	name = strings.ToLower(name)
+	suffix = strings.ToLower(suffix)
+	return name == suffix || strings.HasSuffix(name, "."+suffix)
+}
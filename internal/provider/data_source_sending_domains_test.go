@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
+)
+
+func TestSendingDomainsDataSource_Metadata(t *testing.T) {
+	d := &SendingDomainsDataSource{}
+
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "mailtrap"}, resp)
+
+	expected := "mailtrap_sending_domains"
+	if resp.TypeName != expected {
+		t.Errorf("Expected type name %s, got %s", expected, resp.TypeName)
+	}
+}
+
+func TestSendingDomainsDataSource_Read(t *testing.T) {
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+	account := server.AddAccount("Test Account")
+	mail := server.AddSendingDomain(account.ID, "mail.example.com")
+	news := server.AddSendingDomain(account.ID, "News.Example.com")
+	other := server.AddSendingDomain(account.ID, "example.org")
+	apex := server.AddSendingDomain(account.ID, "Example.com")
+	lookalike := server.AddSendingDomain(account.ID, "badexample.com")
+	server.UpdateSendingDomain(news.ID, func(domain *client.SendingDomain) {
+		domain.Status = "verified"
+		domain.ComplianceStatus = "compliant"
+	})
+
+	d := &SendingDomainsDataSource{client: testServerClient(server), accountID: int64(account.ID)}
+
+	tests := []struct {
+		name     string
+		model    SendingDomainsDataSourceModel
+		expected []int
+	}{
+		{
+			name:     "no filters",
+			model:    SendingDomainsDataSourceModel{},
+			expected: []int{mail.ID, news.ID, other.ID, apex.ID, lookalike.ID},
+		},
+		{
+			name:     "name_suffix",
+			model:    SendingDomainsDataSourceModel{NameSuffix: types.StringValue(".EXAMPLE.com")},
+			expected: []int{mail.ID, news.ID, apex.ID},
+		},
+		{
+			name:     "name_suffix without leading dot",
+			model:    SendingDomainsDataSourceModel{NameSuffix: types.StringValue("example.com")},
+			expected: []int{mail.ID, news.ID, apex.ID},
+		},
+		{
+			name:     "name_suffix of a lookalike domain",
+			model:    SendingDomainsDataSourceModel{NameSuffix: types.StringValue("badexample.com")},
+			expected: []int{lookalike.ID},
+		},
+		{
+			name:     "empty name_suffix",
+			model:    SendingDomainsDataSourceModel{NameSuffix: types.StringValue("")},
+			expected: []int{mail.ID, news.ID, other.ID, apex.ID, lookalike.ID},
+		},
+		{
+			name:     "status",
+			model:    SendingDomainsDataSourceModel{Status: types.StringValue("pending")},
+			expected: []int{mail.ID, other.ID, apex.ID, lookalike.ID},
+		},
+		{
+			name:     "compliance_status",
+			model:    SendingDomainsDataSourceModel{ComplianceStatus: types.StringValue("compliant")},
+			expected: []int{news.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := testDataSourceRead(t, d, tt.model)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+			}
+
+			var data SendingDomainsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+			if len(data.SendingDomains) != len(tt.expected) {
+				t.Fatalf("Expected %d sending domains, got %d", len(tt.expected), len(data.SendingDomains))
+			}
+			for i, id := range tt.expected {
+				if data.SendingDomains[i].ID.ValueInt64() != int64(id) {
+					t.Errorf("Expected sending domain %d at index %d, got %d", id, i, data.SendingDomains[i].ID.ValueInt64())
+				}
+				if data.SendingDomains[i].DNSRecords.IsNull() {
+					t.Errorf("Expected DNS records for sending domain %d", id)
+				}
+			}
+		})
+	}
+}
+
+func TestAccSendingDomainsDataSource(t *testing.T) {
+	server, account := testAccServer(t)
+	domain := server.AddSendingDomain(account.ID, "mail.example.com")
+	server.AddSendingDomain(account.ID, "example.org")
+	server.AddSendingDomain(account.ID, "badexample.com")
+	dataSourceName := "data.mailtrap_sending_domains.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderConfig(server, account.ID) + `
+data "mailtrap_sending_domains" "test" {
+  name_suffix = ".example.com"
+  status      = "pending"
+}
+`,
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(dataSourceName, "sending_domains.#", "1"),
+					tfresource.TestCheckResourceAttr(dataSourceName, "sending_domains.0.id", fmt.Sprint(domain.ID)),
+					tfresource.TestCheckResourceAttr(dataSourceName, "sending_domains.0.dns_records.txt.0.value", domain.DNSRecords.TXT[0].Value),
+				),
+			},
+		},
+	})
+}
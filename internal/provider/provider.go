@@ -2,10 +2,18 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,6 +22,31 @@ import (
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
 )
 
+const (
+	// defaultMaxRetries is the number of retries used when max_retries is unset.
+	defaultMaxRetries = 3
+
+	// defaultRetryMaxWait is the retry wait cap in seconds used when
+	// retry_max_wait is unset.
+	defaultRetryMaxWait = 30
+
+	// defaultRateLimit is the sustained number of requests per second used
+	// when rate_limit is unset.
+	defaultRateLimit = 10
+
+	// defaultRateLimitBurst is the burst size used when rate_limit_burst is
+	// unset.
+	defaultRateLimitBurst = 10
+
+	// defaultRequestTimeout is the per-request timeout in seconds used when
+	// request_timeout is unset.
+	defaultRequestTimeout = 30
+)
+
+// defaultOperationTimeout bounds a resource create, read, update or delete,
+// retries included, when its timeouts block does not set one.
+const defaultOperationTimeout = 20 * time.Minute
+
 // Ensure MailtrapProvider satisfies various provider interfaces.
 var (
 	_ provider.Provider = &MailtrapProvider{}
@@ -29,8 +62,32 @@ type MailtrapProvider struct {
 
 // MailtrapProviderModel describes the provider data model.
 type MailtrapProviderModel struct {
-	APIToken  types.String `tfsdk:"api_token"`
-	AccountID types.Int64  `tfsdk:"account_id"`
+	Profile      types.String `tfsdk:"profile"`
+	APIToken     types.String `tfsdk:"api_token"`
+	AccountID    types.Int64  `tfsdk:"account_id"`
+	AccountName  types.String `tfsdk:"account_name"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	RateLimit      types.Float64 `tfsdk:"rate_limit"`
+	RateLimitBurst types.Int64   `tfsdk:"rate_limit_burst"`
+	RequestTimeout types.Int64   `tfsdk:"request_timeout"`
+
+	BaseURL       types.String `tfsdk:"base_url"`
+	SendingAPIURL types.String `tfsdk:"sending_api_url"`
+	BulkAPIURL    types.String `tfsdk:"bulk_api_url"`
+	SandboxAPIURL types.String `tfsdk:"sandbox_api_url"`
+
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *MailtrapProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,13 +98,90 @@ func (p *MailtrapProvider) Metadata(ctx context.Context, req provider.MetadataRe
 func (p *MailtrapProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the credentials file profile to read `api_token`, `account_id` and the API endpoints from. The credentials file is `~/.mailtrap/credentials`, or the path in the MAILTRAP_CONFIG_FILE environment variable, and uses INI/TOML syntax with one `[name]` section per profile. Falls back to the MAILTRAP_PROFILE environment variable, then `default`. A profile set here or in MAILTRAP_PROFILE must exist; a missing `default` profile is ignored, and invalid lines of the implicit `~/.mailtrap/credentials` are skipped with a warning.",
+				Optional:            true,
+			},
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "API token for Mailtrap authentication. Can also be set via MAILTRAP_API_TOKEN environment variable.",
+				MarkdownDescription: "API token for Mailtrap authentication. Takes precedence over the MAILTRAP_API_TOKEN environment variable, which takes precedence over `api_token` in the credentials file profile.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"account_id": schema.Int64Attribute{
-				MarkdownDescription: "Default account ID to use for resources. Can also be set via MAILTRAP_ACCOUNT_ID environment variable.",
+				MarkdownDescription: "Default account ID to use for resources. Takes precedence over the MAILTRAP_ACCOUNT_ID environment variable, then `account_name`, then `account_id` in the credentials file profile. When none of them is set and the API token can access exactly one account, that account is used.",
+				Optional:            true,
+			},
+			"account_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the default account to use for resources, looked up among the accounts the API token can access. Cannot be combined with `account_id` or MAILTRAP_ACCOUNT_ID, and cannot be used with `skip_credentials_validation`. Can also be set via MAILTRAP_ACCOUNT_NAME environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a rate limit (429) or transient server error (502, 503, 504). Defaults to 3. Set to 0 to disable retries.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds to wait between retries, including waits requested through the Retry-After header. Defaults to 30.",
+				Optional:            true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to the Mailtrap API, shared by all resources and data sources of this provider. Mailtrap allows 150 requests per 10 seconds per token. Defaults to 10. Set to 0 to disable client-side rate limiting.",
+				Optional:            true,
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests that may be sent at once before `rate_limit` applies. Defaults to 10.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds a single request may take, including reading the response. Retries are not included; use the `timeouts` block of a resource to bound a whole operation. Defaults to 30.",
+				Optional:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the general Mailtrap API (accounts, projects, inboxes, sending domains). Defaults to `https://mailtrap.io`. Takes precedence over the MAILTRAP_BASE_URL environment variable, which takes precedence over `base_url` in the credentials file profile.",
+				Optional:            true,
+			},
+			"sending_api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the transactional sending API. Defaults to `https://send.api.mailtrap.io`. Takes precedence over the MAILTRAP_SENDING_API_URL environment variable, which takes precedence over `sending_api_url` in the credentials file profile.",
+				Optional:            true,
+			},
+			"bulk_api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the bulk sending API. Defaults to `https://bulk.api.mailtrap.io`. Takes precedence over the MAILTRAP_BULK_API_URL environment variable, which takes precedence over `bulk_api_url` in the credentials file profile.",
+				Optional:            true,
+			},
+			"sandbox_api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the email testing (sandbox) sending API. Defaults to `https://sandbox.api.mailtrap.io`. Takes precedence over the MAILTRAP_SANDBOX_API_URL environment variable, which takes precedence over `sandbox_api_url` in the credentials file profile.",
+				Optional:            true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the User-Agent header sent with every request, for example to identify a pipeline. Can also be set via MAILTRAP_USER_AGENT_SUFFIX environment variable.",
+				Optional:            true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skips the `GET /api/accounts` request the provider sends when it is configured to check that the API token is valid and can access `account_id`. Useful for plans without network access. Defaults to `false`. Can also be set via MAILTRAP_SKIP_CREDENTIALS_VALIDATION environment variable.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy every request is sent through, for example `http://proxy.internal:3128`. `http`, `https` and `socks5` proxies are supported. When unset, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply. Can also be set via MAILTRAP_HTTP_PROXY environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of CA certificates trusted in addition to the system ones, for example the CA of a TLS-intercepting proxy. Can also be set via MAILTRAP_CA_CERT_FILE environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates trusted in addition to the system ones. Can be combined with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate presented to servers that require mutual TLS. Requires `client_key`. Can also be set via MAILTRAP_CLIENT_CERT environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of `client_cert`. Can also be set via MAILTRAP_CLIENT_KEY environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disables verification of server certificates. Only use this to debug connection problems: anyone on the network path can read and change requests, including the API token. Defaults to `false`.",
 				Optional:            true,
 			},
 		},
@@ -63,29 +197,75 @@ func (p *MailtrapProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Load the credentials file profile, the lowest precedence source of the
+	// token, account ID and endpoints
+	profileName := stringValueOrEnv(data.Profile, "MAILTRAP_PROFILE")
+	profileRequired := profileName != ""
+	if !profileRequired {
+		profileName = defaultProfile
+	}
+	// Only a file or profile the configuration chose is strictly parsed;
+	// problems in the implicit ~/.mailtrap/credentials are warnings
+	profileStrict := profileRequired || os.Getenv(credentialsFileEnvVar) != ""
+
+	credentialsPath, err := credentialsFilePath()
+	if err != nil && profileRequired {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Locate Credentials File",
+			fmt.Sprintf("The credentials file for profile %q could not be located: %s. Set the MAILTRAP_CONFIG_FILE environment variable to its path.", profileName, err),
+		)
+		return
+	}
+
+	profile := credentialsProfile{}
+	if credentialsPath != "" {
+		var warnings []error
+		profile, warnings, err = loadCredentialsProfile(credentialsPath, profileName, profileRequired, profileStrict)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Invalid Credentials File",
+				fmt.Sprintf("The credentials for profile %q could not be loaded: %s", profileName, err),
+			)
+			return
+		}
+		for _, warning := range warnings {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("profile"),
+				"Invalid Credentials File",
+				fmt.Sprintf("Part of the default credentials file could not be read and was ignored: %s", warning),
+			)
+		}
+	}
+
 	// Check for API token
 	apiToken := os.Getenv("MAILTRAP_API_TOKEN")
 	if !data.APIToken.IsNull() {
 		apiToken = data.APIToken.ValueString()
 	}
+	if apiToken == "" {
+		apiToken = profile["api_token"]
+	}
 
 	if apiToken == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
 			"Missing Mailtrap API Token",
 			"The provider cannot create the Mailtrap API client as there is a missing or empty value for the Mailtrap API token. "+
-				"Set the api_token value in the configuration or use the MAILTRAP_API_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the api_token value in the configuration, use the MAILTRAP_API_TOKEN environment variable, or add api_token to the credentials file profile. "+
+				"If any of them is already set, ensure the value is not empty.",
 		)
 		return
 	}
 
-	// Check for account ID
+	// Check for account ID. accounts holds the candidates when the token
+	// can access several accounts and none is chosen.
 	var accountID int64
+	var accounts []client.Account
 	accountIDStr := os.Getenv("MAILTRAP_ACCOUNT_ID")
 	if accountIDStr != "" {
 		// Parse account ID from environment variable
-		var err error
 		accountID, err = parseInt64(accountIDStr)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -99,13 +279,190 @@ func (p *MailtrapProvider) Configure(ctx context.Context, req provider.Configure
 		accountID = data.AccountID.ValueInt64()
 	}
 
+	accountName := stringValueOrEnv(data.AccountName, "MAILTRAP_ACCOUNT_NAME")
+	if accountName != "" && accountID != 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("account_name"),
+			"Conflicting Account Settings",
+			"account_name (or the MAILTRAP_ACCOUNT_NAME environment variable) cannot be combined with account_id or the MAILTRAP_ACCOUNT_ID environment variable. Set only one of them.",
+		)
+		return
+	}
+
+	if profileAccountID := profile["account_id"]; profileAccountID != "" && accountID == 0 && accountName == "" {
+		accountID, err = parseInt64(profileAccountID)
+		if err != nil && !profileStrict {
+			accountID = 0
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("profile"),
+				"Invalid Account ID",
+				fmt.Sprintf("The account_id of the default credentials file profile contains an invalid value and was ignored: %s", profileAccountID),
+			)
+		} else if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Invalid Account ID",
+				fmt.Sprintf("The account_id of credentials file profile %q contains an invalid value: %s", profileName, profileAccountID),
+			)
+			return
+		}
+	}
+
 	// Create Mailtrap client
 	client := client.NewClient(apiToken)
 
+	// Configure retry policy
+	maxRetries := int64(defaultMaxRetries)
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid Max Retries",
+			fmt.Sprintf("max_retries must be zero or greater, got: %d", maxRetries),
+		)
+		return
+	}
+
+	retryMaxWait := int64(defaultRetryMaxWait)
+	if !data.RetryMaxWait.IsNull() {
+		retryMaxWait = data.RetryMaxWait.ValueInt64()
+	}
+	if retryMaxWait < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid Retry Max Wait",
+			fmt.Sprintf("retry_max_wait must be at least 1 second, got: %d", retryMaxWait),
+		)
+		return
+	}
+
+	client.SetUserAgent(userAgent(p.version, req.TerraformVersion, stringValueOrEnv(data.UserAgentSuffix, "MAILTRAP_USER_AGENT_SUFFIX")))
+
+	client.SetRetryPolicy(int(maxRetries), time.Duration(retryMaxWait)*time.Second)
+
+	// Configure client-side rate limit
+	rateLimit := float64(defaultRateLimit)
+	if !data.RateLimit.IsNull() {
+		rateLimit = data.RateLimit.ValueFloat64()
+	}
+	if rateLimit < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit"),
+			"Invalid Rate Limit",
+			fmt.Sprintf("rate_limit must be zero or greater, got: %g", rateLimit),
+		)
+		return
+	}
+
+	rateLimitBurst := int64(defaultRateLimitBurst)
+	if !data.RateLimitBurst.IsNull() {
+		rateLimitBurst = data.RateLimitBurst.ValueInt64()
+	}
+	if rateLimitBurst < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit_burst"),
+			"Invalid Rate Limit Burst",
+			fmt.Sprintf("rate_limit_burst must be at least 1, got: %d", rateLimitBurst),
+		)
+		return
+	}
+
+	client.SetRateLimit(rateLimit, int(rateLimitBurst))
+
+	// Configure per-request timeout
+	requestTimeout := int64(defaultRequestTimeout)
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout = data.RequestTimeout.ValueInt64()
+	}
+	if requestTimeout < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid Request Timeout",
+			fmt.Sprintf("request_timeout must be at least 1 second, got: %d", requestTimeout),
+		)
+		return
+	}
+
+	client.SetTimeout(time.Duration(requestTimeout) * time.Second)
+
+	// Configure API endpoints
+	endpoints := []struct {
+		attribute string
+		envVar    string
+		value     types.String
+		set       func(string)
+	}{
+		{"base_url", "MAILTRAP_BASE_URL", data.BaseURL, client.SetBaseURL},
+		{"sending_api_url", "MAILTRAP_SENDING_API_URL", data.SendingAPIURL, client.SetSendingAPIURL},
+		{"bulk_api_url", "MAILTRAP_BULK_API_URL", data.BulkAPIURL, client.SetBulkAPIURL},
+		{"sandbox_api_url", "MAILTRAP_SANDBOX_API_URL", data.SandboxAPIURL, client.SetSandboxAPIURL},
+	}
+	for _, endpoint := range endpoints {
+		endpointURL := stringValueOrEnv(endpoint.value, endpoint.envVar)
+		if endpointURL == "" {
+			endpointURL = profile[endpoint.attribute]
+		}
+		if endpointURL == "" {
+			continue
+		}
+
+		if err := validateEndpointURL(endpointURL); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(endpoint.attribute),
+				"Invalid API Endpoint",
+				fmt.Sprintf("The %s value (or %s environment variable, or credentials file profile setting) is not a valid URL: %s", endpoint.attribute, endpoint.envVar, err),
+			)
+			continue
+		}
+
+		endpoint.set(endpointURL)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configure proxy and TLS settings
+	transport := newTransport(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if transport != nil {
+		client.SetTransport(transport)
+	}
+
+	// Validate the token and account access
+	skipValidation, err := boolValueOrEnv(data.SkipCredentialsValidation, "MAILTRAP_SKIP_CREDENTIALS_VALIDATION")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("skip_credentials_validation"),
+			"Invalid Skip Credentials Validation",
+			fmt.Sprintf("The MAILTRAP_SKIP_CREDENTIALS_VALIDATION environment variable must be true or false: %s", err),
+		)
+		return
+	}
+	if skipValidation {
+		if accountName != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("account_name"),
+				"Account Name Requires Credentials Validation",
+				"account_name is looked up through the Mailtrap API, which skip_credentials_validation disables. Set account_id instead.",
+			)
+			return
+		}
+	} else {
+		accountID, accounts = resolveAccountID(ctx, client, accountID, accountName, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Create provider data
 	providerData := &ProviderData{
 		Client:    client,
 		AccountID: accountID,
+		Accounts:  accounts,
 	}
 
 	// Make provider data available to resources and data sources
@@ -124,9 +481,13 @@ func (p *MailtrapProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *MailtrapProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAccountDataSource,
+		NewAccountsDataSource,
 		NewProjectDataSource,
+		NewProjectsDataSource,
 		NewInboxDataSource,
+		NewInboxesDataSource,
 		NewSendingDomainDataSource,
+		NewSendingDomainsDataSource,
 	}
 }
 
@@ -143,9 +504,290 @@ func New(version string) func() provider.Provider {
 type ProviderData struct {
 	Client    *client.Client
 	AccountID int64
+
+	// Accounts lists the accounts the API token can access when there are
+	// several and none was chosen as the default, so a missing account ID
+	// can be reported with the candidates.
+	Accounts []client.Account
 }
 
 // Helper function to parse int64
 func parseInt64(s string) (int64, error) {
 	return strconv.ParseInt(s, 10, 64)
 }
+
+// stringValueOrEnv returns the configured value, falling back to the given
+// environment variable when the attribute is not set.
+func stringValueOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// boolValueOrEnv returns the configured value, falling back to the given
+// environment variable, parsed with strconv.ParseBool, when the attribute is
+// not set.
+func boolValueOrEnv(value types.Bool, envVar string) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+	if raw := os.Getenv(envVar); raw != "" {
+		return strconv.ParseBool(raw)
+	}
+	return false, nil
+}
+
+// userAgent builds the User-Agent header identifying the provider and
+// Terraform versions, followed by an optional suffix.
+func userAgent(providerVersion, terraformVersion, suffix string) string {
+	if providerVersion == "" {
+		providerVersion = "dev"
+	}
+
+	ua := fmt.Sprintf("terraform-provider-mailtrap/%s", providerVersion)
+	if terraformVersion != "" {
+		ua += fmt.Sprintf(" (+terraform %s)", terraformVersion)
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
+
+// validateEndpointURL checks that an API endpoint is an absolute http(s) URL.
+func validateEndpointURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("missing host")
+	}
+	return nil
+}
+
+// newTransport builds the HTTP transport for the proxy and TLS attributes.
+// It returns nil when none are set, so the client keeps its default
+// transport. Invalid values are reported against their attribute.
+func newTransport(data MailtrapProviderModel, diags *diag.Diagnostics) http.RoundTripper {
+	var opts client.TransportOptions
+	configured := false
+
+	if proxy := stringValueOrEnv(data.HTTPProxy, "MAILTRAP_HTTP_PROXY"); proxy != "" {
+		proxyURL, err := parseProxyURL(proxy)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid HTTP Proxy",
+				fmt.Sprintf("The http_proxy value (or MAILTRAP_HTTP_PROXY environment variable) is not a valid proxy URL: %s", err),
+			)
+		}
+		opts.ProxyURL = proxyURL
+		configured = true
+	}
+
+	caFile := stringValueOrEnv(data.CACertFile, "MAILTRAP_CA_CERT_FILE")
+	caPEM := data.CACertPEM.ValueString()
+	if caFile != "" || caPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if caFile != "" {
+			pemData, err := os.ReadFile(caFile)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid CA Certificate File",
+					fmt.Sprintf("The CA certificate file could not be read: %s", err),
+				)
+			} else if !pool.AppendCertsFromPEM(pemData) {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid CA Certificate File",
+					fmt.Sprintf("%s does not contain any PEM-encoded certificates.", caFile),
+				)
+			}
+		}
+		if caPEM != "" && !pool.AppendCertsFromPEM([]byte(caPEM)) {
+			diags.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid CA Certificate",
+				"ca_cert_pem does not contain any PEM-encoded certificates.",
+			)
+		}
+
+		opts.RootCAs = pool
+		configured = true
+	}
+
+	clientCert := stringValueOrEnv(data.ClientCert, "MAILTRAP_CLIENT_CERT")
+	clientKey := stringValueOrEnv(data.ClientKey, "MAILTRAP_CLIENT_KEY")
+	switch {
+	case clientCert != "" && clientKey == "":
+		diags.AddAttributeError(
+			path.Root("client_key"),
+			"Missing Client Key",
+			"client_key must be set together with client_cert.",
+		)
+	case clientCert == "" && clientKey != "":
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Missing Client Certificate",
+			"client_cert must be set together with client_key.",
+		)
+	case clientCert != "":
+		cert, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid Client Certificate",
+				fmt.Sprintf("client_cert and client_key are not a valid PEM-encoded certificate and key pair: %s", err),
+			)
+		}
+		opts.Certificates = []tls.Certificate{cert}
+		configured = true
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"insecure_skip_verify is set, so the provider does not verify the certificate of the Mailtrap API. "+
+				"Anyone on the network path can read and change requests, including the API token. "+
+				"Trust the certificate with ca_cert_file or ca_cert_pem instead.",
+		)
+		opts.InsecureSkipVerify = true
+		configured = true
+	}
+
+	if !configured {
+		return nil
+	}
+	return client.NewTransport(opts)
+}
+
+// parseProxyURL parses the http_proxy value, which must be an absolute URL
+// with an http, https or socks5 scheme.
+func parseProxyURL(proxy string) (*url.URL, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("scheme must be http, https or socks5, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing host")
+	}
+	return u, nil
+}
+
+// resolveAccountID lists the accounts the API token can access, so a bad
+// token or an inaccessible account_id is reported against its attribute
+// when the provider is configured rather than by the first resource. It
+// returns the default account: accountID when set, otherwise the account
+// named accountName, otherwise the only account of the token. A token with
+// several accounts and no choice leaves the default at 0, for resources that
+// set their own account_id, and returns the accounts as candidates.
+func resolveAccountID(ctx context.Context, c *client.Client, accountID int64, accountName string, diags *diag.Diagnostics) (int64, []client.Account) {
+	accounts, err := c.Accounts.List(ctx)
+	if err != nil {
+		if client.IsUnauthorized(err) || client.IsForbidden(err) {
+			diags.AddAttributeError(
+				path.Root("api_token"),
+				"Invalid Mailtrap API Token",
+				fmt.Sprintf("Mailtrap rejected the API token: %s. "+
+					"Check the api_token value, the MAILTRAP_API_TOKEN environment variable or the credentials file profile.", err),
+			)
+			return 0, nil
+		}
+		diags.AddError(
+			"Unable to Validate Mailtrap Credentials",
+			fmt.Sprintf("The provider could not list the accounts of the API token: %s. "+
+				"Set skip_credentials_validation to true to configure the provider without reaching the API.", err),
+		)
+		return 0, nil
+	}
+
+	switch {
+	case accountName != "":
+		var matches []client.Account
+		for _, account := range accounts {
+			if account.Name == accountName {
+				matches = append(matches, account)
+			}
+		}
+		switch len(matches) {
+		case 1:
+			return int64(matches[0].ID), nil
+		case 0:
+			diags.AddAttributeError(
+				path.Root("account_name"),
+				"Unknown Mailtrap Account",
+				fmt.Sprintf("The API token cannot access an account named %q. Accessible accounts: %s.", accountName, describeAccounts(accounts)),
+			)
+		default:
+			diags.AddAttributeError(
+				path.Root("account_name"),
+				"Ambiguous Mailtrap Account Name",
+				fmt.Sprintf("The API token can access several accounts named %q: %s. Set account_id to choose one.", accountName, describeAccounts(matches)),
+			)
+		}
+		return 0, nil
+
+	case accountID != 0:
+		for _, account := range accounts {
+			if int64(account.ID) == accountID {
+				return accountID, nil
+			}
+		}
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Inaccessible Mailtrap Account",
+			fmt.Sprintf("The API token cannot access account %d. Accessible accounts: %s. "+
+				"Check the account_id value, the MAILTRAP_ACCOUNT_ID environment variable or the credentials file profile.", accountID, describeAccounts(accounts)),
+		)
+		return 0, nil
+	}
+
+	switch len(accounts) {
+	case 0:
+		return 0, nil
+	case 1:
+		return int64(accounts[0].ID), nil
+	}
+	return 0, accounts
+}
+
+// missingAccountIDDetail explains that neither the resource or data source
+// configuration, named by configuration, nor the provider set an account ID.
+// It names the candidate accounts when the token can access several.
+func missingAccountIDDetail(configuration string, accounts []client.Account) string {
+	detail := fmt.Sprintf("Account ID must be provided either in the %s configuration or provider configuration", configuration)
+	if len(accounts) > 0 {
+		detail += fmt.Sprintf(". The API token can access several accounts: %s. "+
+			"Set account_id here, or account_id or account_name in the provider configuration to choose a default account.", describeAccounts(accounts))
+	}
+	return detail
+}
+
+// describeAccounts lists accounts as "ID (name)" for diagnostics.
+func describeAccounts(accounts []client.Account) string {
+	if len(accounts) == 0 {
+		return "none"
+	}
+
+	descriptions := make([]string, len(accounts))
+	for i, account := range accounts {
+		descriptions[i] = fmt.Sprintf("%d (%s)", account.ID, account.Name)
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -2,12 +2,126 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
 )
 
+// testAccProtoV6ProviderFactories are used to instantiate the provider during
+// acceptance testing. The factory function is called for each Terraform CLI
+// command executed to create a provider server to which the CLI can reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"mailtrap": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck clears the provider environment variables and points the
+// credentials file at a path that does not exist, so acceptance tests only
+// ever reach the fake API configured by testAccProviderConfig, never a real
+// Mailtrap account.
+func testAccPreCheck(t *testing.T) {
+	t.Setenv("MAILTRAP_CONFIG_FILE", filepath.Join(t.TempDir(), "credentials"))
+
+	for _, envVar := range []string{
+		"MAILTRAP_PROFILE",
+		"MAILTRAP_API_TOKEN",
+		"MAILTRAP_ACCOUNT_ID",
+		"MAILTRAP_ACCOUNT_NAME",
+		"MAILTRAP_BASE_URL",
+		"MAILTRAP_SENDING_API_URL",
+		"MAILTRAP_BULK_API_URL",
+		"MAILTRAP_SANDBOX_API_URL",
+		"MAILTRAP_HTTP_PROXY",
+		"MAILTRAP_CA_CERT_FILE",
+		"MAILTRAP_CLIENT_CERT",
+		"MAILTRAP_CLIENT_KEY",
+		"MAILTRAP_SKIP_CREDENTIALS_VALIDATION",
+	} {
+		t.Setenv(envVar, "")
+	}
+}
+
+// testAccServer starts a fake Mailtrap API holding a single account for an
+// acceptance test. The server is closed when the test finishes.
+func testAccServer(t *testing.T) (*fakemailtrap.Server, client.Account) {
+	t.Helper()
+
+	server := fakemailtrap.NewServer()
+	t.Cleanup(server.Close)
+
+	return server, server.AddAccount("Acceptance Tests")
+}
+
+// testAccProviderConfig returns a provider block pointing at the fake API.
+func testAccProviderConfig(server *fakemailtrap.Server, accountID int) string {
+	return fmt.Sprintf(`
+provider "mailtrap" {
+  api_token   = %q
+  account_id  = %d
+  base_url    = %q
+  max_retries = 0
+  rate_limit  = 0
+}
+`, server.Token(), accountID, server.URL)
+}
+
+// testAccImportStateIDFunc builds the account_id/id import ID of a resource
+// from its state.
+func testAccImportStateIDFunc(resourceName string) tfresource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["account_id"], rs.Primary.ID), nil
+	}
+}
+
+// testAccResourceID returns the numeric ID of a resource in the state.
+func testAccResourceID(s *terraform.State, resourceName string) (int, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return 0, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	var id int
+	if _, err := fmt.Sscanf(rs.Primary.ID, "%d", &id); err != nil {
+		return 0, fmt.Errorf("resource %s has a non-numeric ID %q", resourceName, rs.Primary.ID)
+	}
+	return id, nil
+}
+
+// testAccCaptureID stores the numeric ID of a resource so later steps can
+// change it behind Terraform's back.
+func testAccCaptureID(resourceName string, id *int) tfresource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var err error
+		*id, err = testAccResourceID(s, resourceName)
+		return err
+	}
+}
+
 func TestMailtrapProvider_Metadata(t *testing.T) {
 	p := &MailtrapProvider{version: "test"}
 	
@@ -60,6 +174,22 @@ func TestMailtrapProvider_Schema(t *testing.T) {
 	if !accountIDAttr.IsOptional() {
 		t.Error("Expected account_id to be optional")
 	}
+	
+	// Check retry and endpoint attributes
+	for _, name := range []string{"max_retries", "retry_max_wait", "rate_limit", "rate_limit_burst", "request_timeout", "base_url", "sending_api_url", "bulk_api_url", "sandbox_api_url", "user_agent_suffix", "profile", "skip_credentials_validation", "http_proxy", "ca_cert_file", "ca_cert_pem", "client_cert", "client_key", "insecure_skip_verify"} {
+		attr, exists := resp.Schema.Attributes[name]
+		if !exists {
+			t.Fatalf("Expected %s attribute to exist", name)
+		}
+		
+		if !attr.IsOptional() {
+			t.Errorf("Expected %s to be optional", name)
+		}
+	}
+
+	if !resp.Schema.Attributes["client_key"].IsSensitive() {
+		t.Error("Expected client_key to be sensitive")
+	}
 }
 
 func TestMailtrapProvider_Configure_Success(t *testing.T) {
@@ -96,7 +226,7 @@ func TestMailtrapProvider_DataSources(t *testing.T) {
 	
 	dataSources := p.DataSources(context.Background())
 	
-	expectedCount := 4 // account, project, inbox, sending_domain
+	expectedCount := 8 // account, accounts, project, projects, inbox, inboxes, sending_domain, sending_domains
 	if len(dataSources) != expectedCount {
 		t.Errorf("Expected %d data sources, got %d", expectedCount, len(dataSources))
 	}
@@ -167,6 +297,92 @@ func TestParseInt64(t *testing.T) {
 	}
 }
 
+func TestStringValueOrEnv(t *testing.T) {
+	t.Setenv("MAILTRAP_TEST_VALUE", "from-env")
+	
+	if got := stringValueOrEnv(types.StringValue("from-config"), "MAILTRAP_TEST_VALUE"); got != "from-config" {
+		t.Errorf("Expected configured value to win, got %s", got)
+	}
+	
+	if got := stringValueOrEnv(types.StringNull(), "MAILTRAP_TEST_VALUE"); got != "from-env" {
+		t.Errorf("Expected environment value, got %s", got)
+	}
+	
+	if got := stringValueOrEnv(types.StringNull(), "MAILTRAP_TEST_UNSET"); got != "" {
+		t.Errorf("Expected empty value, got %s", got)
+	}
+}
+
+func TestBoolValueOrEnv(t *testing.T) {
+	t.Setenv("MAILTRAP_TEST_VALUE", "true")
+
+	if got, _ := boolValueOrEnv(types.BoolValue(false), "MAILTRAP_TEST_VALUE"); got {
+		t.Error("Expected configured value to win")
+	}
+
+	if got, _ := boolValueOrEnv(types.BoolNull(), "MAILTRAP_TEST_VALUE"); !got {
+		t.Error("Expected environment value")
+	}
+
+	if got, err := boolValueOrEnv(types.BoolNull(), "MAILTRAP_TEST_UNSET"); got || err != nil {
+		t.Errorf("Expected false without error, got %t, %v", got, err)
+	}
+
+	t.Setenv("MAILTRAP_TEST_VALUE", "sometimes")
+	if _, err := boolValueOrEnv(types.BoolNull(), "MAILTRAP_TEST_VALUE"); err == nil {
+		t.Error("Expected error for an invalid boolean")
+	}
+}
+
+func TestValidateEndpointURL(t *testing.T) {
+	tests := []struct {
+		input    string
+		hasError bool
+	}{
+		{"https://mailtrap.io", false},
+		{"http://localhost:8080", false},
+		{"http://127.0.0.1:9999/prefix", false},
+		{"localhost:8080", true},
+		{"ftp://mailtrap.io", true},
+		{"https://", true},
+		{"://bad", true},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			err := validateEndpointURL(tt.input)
+			if tt.hasError && err == nil {
+				t.Errorf("Expected error for %s, got nil", tt.input)
+			}
+			if !tt.hasError && err != nil {
+				t.Errorf("Expected no error for %s, got %v", tt.input, err)
+			}
+		})
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		providerVersion  string
+		terraformVersion string
+		suffix           string
+		expected         string
+	}{
+		{"1.2.3", "1.6.0", "", "terraform-provider-mailtrap/1.2.3 (+terraform 1.6.0)"},
+		{"1.2.3", "1.6.0", "ci-pipeline/42", "terraform-provider-mailtrap/1.2.3 (+terraform 1.6.0) ci-pipeline/42"},
+		{"1.2.3", "", "", "terraform-provider-mailtrap/1.2.3"},
+		{"", "1.6.0", "  ", "terraform-provider-mailtrap/dev (+terraform 1.6.0)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := userAgent(tt.providerVersion, tt.terraformVersion, tt.suffix); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
 // TestProviderServer tests that the provider can be served
 func TestProviderServer(t *testing.T) {
 	// Create a test provider server
@@ -177,6 +393,495 @@ func TestProviderServer(t *testing.T) {
 }
 
 // Helper function to create a test provider for integration tests
+func TestMailtrapProvider_Configure_CACertPEM(t *testing.T) {
+	testAccPreCheck(t)
+	server := testTLSServer(t, false)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url":    tftypes.NewValue(tftypes.String, server.URL),
+		"ca_cert_pem": tftypes.NewValue(tftypes.String, string(server.CAPEM())),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_CACertFile(t *testing.T) {
+	testAccPreCheck(t)
+	server := testTLSServer(t, false)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, server.CAPEM(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url":     tftypes.NewValue(tftypes.String, server.URL),
+		"ca_cert_file": tftypes.NewValue(tftypes.String, caFile),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_ClientCert(t *testing.T) {
+	testAccPreCheck(t)
+	server := testTLSServer(t, true)
+	certPEM, keyPEM := server.ClientCertPEM()
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url":    tftypes.NewValue(tftypes.String, server.URL),
+		"ca_cert_pem": tftypes.NewValue(tftypes.String, string(server.CAPEM())),
+		"client_cert": tftypes.NewValue(tftypes.String, string(certPEM)),
+		"client_key":  tftypes.NewValue(tftypes.String, string(keyPEM)),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_InsecureSkipVerify(t *testing.T) {
+	testAccPreCheck(t)
+	server := testTLSServer(t, false)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url":             tftypes.NewValue(tftypes.String, server.URL),
+		"insecure_skip_verify": tftypes.NewValue(tftypes.Bool, true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	testProviderListAccounts(t, resp)
+}
+
+func TestMailtrapProvider_Configure_UntrustedCA(t *testing.T) {
+	testAccPreCheck(t)
+	server := testTLSServer(t, false)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected credentials validation to fail when the server CA is not trusted")
+	}
+
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Unable to Validate Mailtrap Credentials" {
+		t.Errorf("Expected a validation error, got %s", summary)
+	}
+}
+
+func TestMailtrapProvider_Configure_InvalidTransport(t *testing.T) {
+	testAccPreCheck(t)
+	server := testTLSServer(t, true)
+	certPEM, _ := server.ClientCertPEM()
+
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		attribute string
+	}{
+		{
+			name:      "proxy scheme",
+			values:    map[string]tftypes.Value{"http_proxy": tftypes.NewValue(tftypes.String, "ftp://proxy.internal")},
+			attribute: "http_proxy",
+		},
+		{
+			name:      "missing CA file",
+			values:    map[string]tftypes.Value{"ca_cert_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing.pem"))},
+			attribute: "ca_cert_file",
+		},
+		{
+			name:      "CA without certificates",
+			values:    map[string]tftypes.Value{"ca_cert_pem": tftypes.NewValue(tftypes.String, "not a certificate")},
+			attribute: "ca_cert_pem",
+		},
+		{
+			name:      "client cert without key",
+			values:    map[string]tftypes.Value{"client_cert": tftypes.NewValue(tftypes.String, string(certPEM))},
+			attribute: "client_key",
+		},
+		{
+			name: "mismatched key",
+			values: map[string]tftypes.Value{
+				"client_cert": tftypes.NewValue(tftypes.String, string(certPEM)),
+				"client_key":  tftypes.NewValue(tftypes.String, "not a key"),
+			},
+			attribute: "client_cert",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := testProviderConfigure(t, tt.values)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error")
+			}
+
+			errs := resp.Diagnostics.Errors()
+			withPath, ok := errs[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root(tt.attribute)) {
+				t.Errorf("Expected the error on %s, got %v", tt.attribute, errs)
+			}
+		})
+	}
+}
+
+func TestMailtrapProvider_Configure_RequestTimeout(t *testing.T) {
+	testAccPreCheck(t)
+	server, _ := testAccServer(t)
+	server.SetLatency(3 * time.Second)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url":                    tftypes.NewValue(tftypes.String, server.URL),
+		"request_timeout":             tftypes.NewValue(tftypes.Number, 1),
+		"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	c := resp.ResourceData.(*ProviderData).Client
+	if _, err := c.Accounts.List(context.Background()); err == nil {
+		t.Error("Expected an error once request_timeout passed")
+	}
+}
+
+func TestMailtrapProvider_Configure_InvalidRequestTimeout(t *testing.T) {
+	testAccPreCheck(t)
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"request_timeout": tftypes.NewValue(tftypes.Number, 0),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a zero request_timeout")
+	}
+
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("request_timeout")) {
+		t.Errorf("Expected the error on request_timeout, got %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestMailtrapProvider_Configure_ValidatesCredentials(t *testing.T) {
+	testAccPreCheck(t)
+	server, account := testAccServer(t)
+	server.AddAccount("Second Account")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url":   tftypes.NewValue(tftypes.String, server.URL),
+		"account_id": tftypes.NewValue(tftypes.Number, account.ID),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 || requests[0].Method != http.MethodGet || requests[0].Path != "/api/accounts" {
+		t.Errorf("Expected a single GET /api/accounts, got %v", requests)
+	}
+}
+
+func TestMailtrapProvider_Configure_InvalidCredentials(t *testing.T) {
+	testAccPreCheck(t)
+	server, account := testAccServer(t)
+
+	tests := []struct {
+		name      string
+		values    map[string]tftypes.Value
+		attribute string
+		detail    string
+	}{
+		{
+			name: "rejected token",
+			values: map[string]tftypes.Value{
+				"api_token": tftypes.NewValue(tftypes.String, "wrong-token"),
+			},
+			attribute: "api_token",
+			detail:    "Mailtrap rejected the API token",
+		},
+		{
+			name: "inaccessible account",
+			values: map[string]tftypes.Value{
+				"account_id": tftypes.NewValue(tftypes.Number, 999999),
+			},
+			attribute: "account_id",
+			detail:    fmt.Sprintf("Accessible accounts: %d (Acceptance Tests)", account.ID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.values["base_url"] = tftypes.NewValue(tftypes.String, server.URL)
+			resp := testProviderConfigure(t, tt.values)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error")
+			}
+
+			errs := resp.Diagnostics.Errors()
+			withPath, ok := errs[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root(tt.attribute)) {
+				t.Errorf("Expected the error on %s, got %v", tt.attribute, errs)
+			}
+			if !strings.Contains(errs[0].Detail(), tt.detail) {
+				t.Errorf("Expected the detail to contain %q, got %s", tt.detail, errs[0].Detail())
+			}
+		})
+	}
+}
+
+func TestMailtrapProvider_Configure_SkipCredentialsValidation(t *testing.T) {
+	testAccPreCheck(t)
+	server, _ := testAccServer(t)
+	t.Setenv("MAILTRAP_SKIP_CREDENTIALS_VALIDATION", "true")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"api_token": tftypes.NewValue(tftypes.String, "wrong-token"),
+		"base_url":  tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("Expected no requests, got %v", requests)
+	}
+}
+
+func TestMailtrapProvider_Configure_DefaultAccount(t *testing.T) {
+	testAccPreCheck(t)
+	server, account := testAccServer(t)
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	providerData := resp.ResourceData.(*ProviderData)
+	if providerData.AccountID != int64(account.ID) {
+		t.Errorf("Expected the only account %d, got %d", account.ID, providerData.AccountID)
+	}
+}
+
+func TestMailtrapProvider_Configure_AccountName(t *testing.T) {
+	testAccPreCheck(t)
+	server, _ := testAccServer(t)
+	staging := server.AddAccount("Staging")
+	t.Setenv("MAILTRAP_ACCOUNT_NAME", "Staging")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	providerData := resp.ResourceData.(*ProviderData)
+	if providerData.AccountID != int64(staging.ID) {
+		t.Errorf("Expected account ID %d from MAILTRAP_ACCOUNT_NAME, got %d", staging.ID, providerData.AccountID)
+	}
+	if requests := server.Requests(); len(requests) != 1 {
+		t.Errorf("Expected a single request, got %v", requests)
+	}
+}
+
+func TestMailtrapProvider_Configure_MultipleAccounts(t *testing.T) {
+	testAccPreCheck(t)
+	server, account := testAccServer(t)
+	staging := server.AddAccount("Staging")
+
+	resp := testProviderConfigure(t, map[string]tftypes.Value{
+		"base_url": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	providerData := resp.ResourceData.(*ProviderData)
+	if providerData.AccountID != 0 {
+		t.Errorf("Expected no default account, got %d", providerData.AccountID)
+	}
+	if len(providerData.Accounts) != 2 || providerData.Accounts[0].ID != account.ID || providerData.Accounts[1].ID != staging.ID {
+		t.Errorf("Expected accounts %d and %d as candidates, got %v", account.ID, staging.ID, providerData.Accounts)
+	}
+}
+
+func TestMissingAccountIDDetail(t *testing.T) {
+	detail := missingAccountIDDetail("resource", nil)
+	if detail != "Account ID must be provided either in the resource configuration or provider configuration" {
+		t.Errorf("Expected the plain detail without candidates, got %s", detail)
+	}
+
+	detail = missingAccountIDDetail("data source", []client.Account{{ID: 1, Name: "Production"}, {ID: 2, Name: "Staging"}})
+	if !strings.Contains(detail, "data source configuration") || !strings.Contains(detail, "1 (Production), 2 (Staging)") {
+		t.Errorf("Expected the detail to name the candidate accounts, got %s", detail)
+	}
+}
+
+// TestAccMailtrapProvider_MultipleAccounts configures the provider without
+// a default account for a token that can access two, with every resource
+// and data source setting its own account_id.
+func TestAccMailtrapProvider_MultipleAccounts(t *testing.T) {
+	server, account := testAccServer(t)
+	other := server.AddAccount("Other Account")
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "mailtrap" {
+  api_token   = %q
+  base_url    = %q
+  max_retries = 0
+  rate_limit  = 0
+}
+
+data "mailtrap_accounts" "all" {}
+
+resource "mailtrap_project" "test" {
+  account_id = %d
+  name       = "Multi Account Project"
+}
+
+resource "mailtrap_inbox" "test" {
+  account_id = %d
+  project_id = mailtrap_project.test.id
+  name       = "Multi Account Inbox"
+}
+
+resource "mailtrap_sending_domain" "test" {
+  account_id = %d
+  name       = "multi.example.com"
+}
+
+data "mailtrap_projects" "other" {
+  account_id = %d
+}
+`, server.Token(), server.URL, account.ID, account.ID, other.ID, other.ID),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.mailtrap_accounts.all", "accounts.#", "2"),
+					tfresource.TestCheckResourceAttr("mailtrap_project.test", "account_id", fmt.Sprint(account.ID)),
+					tfresource.TestCheckResourceAttr("mailtrap_inbox.test", "account_id", fmt.Sprint(account.ID)),
+					tfresource.TestCheckResourceAttr("mailtrap_sending_domain.test", "account_id", fmt.Sprint(other.ID)),
+					tfresource.TestCheckResourceAttr("data.mailtrap_projects.other", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestMailtrapProvider_Configure_DefaultAccountErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		accounts  []string
+		values    map[string]tftypes.Value
+		attribute string
+		detail    string
+	}{
+		{
+			name:     "unknown name",
+			accounts: []string{"Production"},
+			values: map[string]tftypes.Value{
+				"account_name": tftypes.NewValue(tftypes.String, "Staging"),
+			},
+			attribute: "account_name",
+			detail:    "Accessible accounts: ",
+		},
+		{
+			name:     "ambiguous name",
+			accounts: []string{"Staging", "Staging"},
+			values: map[string]tftypes.Value{
+				"account_name": tftypes.NewValue(tftypes.String, "Staging"),
+			},
+			attribute: "account_name",
+			detail:    "several accounts named \"Staging\"",
+		},
+		{
+			name:     "account ID and name",
+			accounts: []string{"Production"},
+			values: map[string]tftypes.Value{
+				"account_id":   tftypes.NewValue(tftypes.Number, 1),
+				"account_name": tftypes.NewValue(tftypes.String, "Production"),
+			},
+			attribute: "account_name",
+			detail:    "cannot be combined with account_id",
+		},
+		{
+			name:     "name without validation",
+			accounts: []string{"Production"},
+			values: map[string]tftypes.Value{
+				"account_name":                tftypes.NewValue(tftypes.String, "Production"),
+				"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+			},
+			attribute: "account_name",
+			detail:    "skip_credentials_validation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testAccPreCheck(t)
+			server := fakemailtrap.NewServer()
+			t.Cleanup(server.Close)
+			for _, name := range tt.accounts {
+				server.AddAccount(name)
+			}
+
+			tt.values["base_url"] = tftypes.NewValue(tftypes.String, server.URL)
+			resp := testProviderConfigure(t, tt.values)
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error")
+			}
+
+			errs := resp.Diagnostics.Errors()
+			withPath, ok := errs[0].(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root(tt.attribute)) {
+				t.Errorf("Expected the error on %s, got %v", tt.attribute, errs)
+			}
+			if !strings.Contains(errs[0].Detail(), tt.detail) {
+				t.Errorf("Expected the detail to contain %q, got %s", tt.detail, errs[0].Detail())
+			}
+		})
+	}
+}
+
+func TestParseProxyURL(t *testing.T) {
+	tests := []struct {
+		input    string
+		hasError bool
+	}{
+		{"http://proxy.internal:3128", false},
+		{"https://proxy.internal", false},
+		{"socks5://127.0.0.1:1080", false},
+		{"proxy.internal:3128", true},
+		{"ftp://proxy.internal", true},
+		{"http://", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := parseProxyURL(tt.input)
+			if tt.hasError && err == nil {
+				t.Errorf("Expected error for %s, got nil", tt.input)
+			}
+			if !tt.hasError && err != nil {
+				t.Errorf("Expected no error for %s, got %v", tt.input, err)
+			}
+		})
+	}
+}
+
 func testProvider() *MailtrapProvider {
 	return &MailtrapProvider{version: "test"}
 }
@@ -187,4 +892,263 @@ func testProviderData() *ProviderData {
 		Client:    nil, // Would be mocked in real tests
 		AccountID: 12345,
 	}
-}
\ No newline at end of file
+}
+
+// testClient starts an httptest server with the given handler and returns a
+// client pointed at it. The server is closed when the test finishes.
+func testClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := client.NewClient("test-token")
+	c.SetBaseURL(server.URL)
+	c.SetRetryPolicy(0, 0)
+	c.SetRateLimit(0, 0)
+	return c
+}
+
+// testServerClient returns a client for the fake Mailtrap API, with retries
+// and rate limiting disabled.
+func testServerClient(server *fakemailtrap.Server) *client.Client {
+	c := client.NewClient(server.Token())
+	c.SetBaseURL(server.URL)
+	c.SetRetryPolicy(0, 0)
+	c.SetRateLimit(0, 0)
+	return c
+}
+
+// testDataSourceConfig builds a Terraform configuration for the data source
+// schema holding the given model.
+func testDataSourceConfig(t *testing.T, d datasource.DataSource, model interface{}) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Failed to build test config: %v", diags.Errors())
+	}
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// testDataSourceRead reads the data source with a configuration holding the
+// given model and returns the response.
+func testDataSourceRead(t *testing.T, d datasource.DataSource, model interface{}) *datasource.ReadResponse {
+	t.Helper()
+
+	config := testDataSourceConfig(t, d, model)
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: config.Schema,
+			Raw:    tftypes.NewValue(config.Schema.Type().TerraformType(context.Background()), nil),
+		},
+	}
+	d.Read(context.Background(), datasource.ReadRequest{Config: config}, resp)
+	return resp
+}
+
+// testResourceState builds a Terraform state for the resource schema holding
+// the given model.
+func testResourceState(t *testing.T, r resource.Resource, model interface{}) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Failed to build test state: %v", diags.Errors())
+	}
+
+	return state
+}
+
+// testResourcePlan builds a Terraform plan for the resource schema holding
+// the given model.
+func testResourcePlan(t *testing.T, r resource.Resource, model interface{}) tfsdk.Plan {
+	t.Helper()
+
+	state := testResourceState(t, r, model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// testEmptyState returns a null state for the resource schema, as passed to
+// Create.
+func testEmptyState(t *testing.T, r resource.Resource) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// testCassetteClient returns a client that replays the named cassette from
+// testdata/cassettes, so the test runs without network access. With
+// MAILTRAP_CASSETTE_MODE=record it talks to the API selected by
+// MAILTRAP_API_TOKEN and MAILTRAP_BASE_URL and rewrites the cassette when
+// the test passes; with passthrough it only talks to the API.
+func testCassetteClient(t *testing.T, name string) *client.Client {
+	t.Helper()
+
+	mode, err := client.CassetteModeFromEnv(client.CassetteReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport, err := client.NewCassetteTransport(filepath.Join("testdata", "cassettes", name+".yaml"), mode, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := "cassette-replay"
+	if mode != client.CassetteReplay {
+		token = os.Getenv("MAILTRAP_API_TOKEN")
+		if token == "" {
+			t.Skipf("MAILTRAP_API_TOKEN must be set to %s cassettes", mode)
+		}
+	}
+
+	c := client.NewClient(token)
+	if baseURL := os.Getenv("MAILTRAP_BASE_URL"); baseURL != "" && mode != client.CassetteReplay {
+		c.SetBaseURL(baseURL)
+	}
+	c.SetRetryPolicy(0, 0)
+	c.SetRateLimit(0, 0)
+	c.SetTransport(transport)
+
+	t.Cleanup(func() {
+		if t.Failed() {
+			return
+		}
+		if err := transport.Save(); err != nil {
+			t.Errorf("Failed to save cassette: %v", err)
+		}
+		if mode == client.CassetteReplay && transport.Unplayed() > 0 {
+			t.Errorf("Expected every recorded interaction to be replayed, %d left in %s", transport.Unplayed(), name)
+		}
+	})
+	return c
+}
+
+// testCassetteAccountID returns the first account the cassette session can
+// access, so recorded sessions carry their own account ID.
+func testCassetteAccountID(t *testing.T, c *client.Client) int64 {
+	t.Helper()
+
+	accounts, err := c.Accounts.List(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to list accounts: %v", err)
+	}
+	if len(accounts) == 0 {
+		t.Fatal("Expected the token to access at least one account")
+	}
+	return int64(accounts[0].ID)
+}
+
+// testTLSServer starts an HTTPS fake Mailtrap API holding one account. The
+// server is closed when the test finishes.
+func testTLSServer(t *testing.T, requireClientCert bool) *fakemailtrap.Server {
+	t.Helper()
+
+	server, err := fakemailtrap.NewTLSServer(requireClientCert)
+	if err != nil {
+		t.Fatalf("Failed to start TLS server: %v", err)
+	}
+	t.Cleanup(server.Close)
+	server.AddAccount("TLS Tests")
+	return server
+}
+
+// testProviderConfigure runs Configure with the given attribute values and
+// the fake's token, leaving every other attribute null. Retries and rate
+// limiting are disabled. Callers clear the provider environment variables
+// with testAccPreCheck first.
+func testProviderConfigure(t *testing.T, values map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	p := testProvider()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{
+		"api_token":   tftypes.NewValue(tftypes.String, fakemailtrap.DefaultToken),
+		"max_retries": tftypes.NewValue(tftypes.Number, 0),
+		"rate_limit":  tftypes.NewValue(tftypes.Number, 0),
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := attributes[name]; !ok {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, attributes),
+		},
+	}, resp)
+	return resp
+}
+
+// testProviderListAccounts checks that the client configured by the
+// provider can reach the API.
+func testProviderListAccounts(t *testing.T, resp *provider.ConfigureResponse) {
+	t.Helper()
+
+	c := resp.ResourceData.(*ProviderData).Client
+	accounts, err := c.Accounts.List(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error listing accounts, got %v", err)
+	}
+	if len(accounts) != 1 {
+		t.Errorf("Expected 1 account, got %d", len(accounts))
+	}
+}
+
+// testTimeouts returns a timeouts block holding the given durations, such
+// as "create": "1s". Operations without a duration are null.
+func testTimeouts(durations map[string]string) timeouts.Value {
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+	if durations == nil {
+		return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+	}
+
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name := range attrTypes {
+		values[name] = types.StringNull()
+		if duration, ok := durations[name]; ok {
+			values[name] = types.StringValue(duration)
+		}
+	}
+	return timeouts.Value{Object: types.ObjectValueMust(attrTypes, values)}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,28 +34,30 @@ func NewInboxResource() resource.Resource {
 type InboxResource struct {
 	client    *client.Client
 	accountID int64
+	accounts  []client.Account
 }
 
 // InboxResourceModel describes the resource data model.
 type InboxResourceModel struct {
-	ID                      types.Int64  `tfsdk:"id"`
-	AccountID               types.Int64  `tfsdk:"account_id"`
-	ProjectID               types.Int64  `tfsdk:"project_id"`
-	Name                    types.String `tfsdk:"name"`
-	Username                types.String `tfsdk:"username"`
-	Password                types.String `tfsdk:"password"`
-	EmailUsername           types.String `tfsdk:"email_username"`
-	EmailUsernameEnabled    types.Bool   `tfsdk:"email_username_enabled"`
-	Domain                  types.String `tfsdk:"domain"`
-	EmailDomain             types.String `tfsdk:"email_domain"`
-	POP3Domain              types.String `tfsdk:"pop3_domain"`
-	SMTPPorts               types.List   `tfsdk:"smtp_ports"`
-	POP3Ports               types.List   `tfsdk:"pop3_ports"`
-	Status                  types.String `tfsdk:"status"`
-	MaxSize                 types.Int64  `tfsdk:"max_size"`
-	SentMessagesCount       types.Int64  `tfsdk:"sent_messages_count"`
-	ForwardedMessagesCount  types.Int64  `tfsdk:"forwarded_messages_count"`
-	ForwardFromEmailAddress types.String `tfsdk:"forward_from_email_address"`
+	ID                      types.Int64    `tfsdk:"id"`
+	AccountID               types.Int64    `tfsdk:"account_id"`
+	ProjectID               types.Int64    `tfsdk:"project_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Username                types.String   `tfsdk:"username"`
+	Password                types.String   `tfsdk:"password"`
+	EmailUsername           types.String   `tfsdk:"email_username"`
+	EmailUsernameEnabled    types.Bool     `tfsdk:"email_username_enabled"`
+	Domain                  types.String   `tfsdk:"domain"`
+	EmailDomain             types.String   `tfsdk:"email_domain"`
+	POP3Domain              types.String   `tfsdk:"pop3_domain"`
+	SMTPPorts               types.List     `tfsdk:"smtp_ports"`
+	POP3Ports               types.List     `tfsdk:"pop3_ports"`
+	Status                  types.String   `tfsdk:"status"`
+	MaxSize                 types.Int64    `tfsdk:"max_size"`
+	SentMessagesCount       types.Int64    `tfsdk:"sent_messages_count"`
+	ForwardedMessagesCount  types.Int64    `tfsdk:"forwarded_messages_count"`
+	ForwardFromEmailAddress types.String   `tfsdk:"forward_from_email_address"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *InboxResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,6 +116,9 @@ func (r *InboxResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Email username part (before @) for the inbox email address",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"email_username_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether email username is enabled",
@@ -188,6 +194,15 @@ func (r *InboxResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -208,6 +223,7 @@ func (r *InboxResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 	r.client = providerData.Client
 	r.accountID = providerData.AccountID
+	r.accounts = providerData.Accounts
 }
 
 func (r *InboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -220,6 +236,15 @@ func (r *InboxResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Determine account ID
 	accountID := r.accountID
 	if !data.AccountID.IsNull() && !data.AccountID.IsUnknown() {
@@ -229,37 +254,29 @@ func (r *InboxResource) Create(ctx context.Context, req resource.CreateRequest,
 	if accountID == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Account ID",
-			"Account ID must be provided either in the resource configuration or provider configuration",
+			missingAccountIDDetail("resource", r.accounts),
 		)
 		return
 	}
 
 	// Create API request
-	createReq := client.InboxRequest{
-		Inbox: struct {
-			Name          string `json:"name"`
-			EmailUsername string `json:"email_username,omitempty"`
-		}{
-			Name: data.Name.ValueString(),
-		},
+	params := client.InboxParams{
+		Name: data.Name.ValueString(),
 	}
 
 	// Add email username if provided
 	if !data.EmailUsername.IsNull() && !data.EmailUsername.IsUnknown() {
-		createReq.Inbox.EmailUsername = data.EmailUsername.ValueString()
+		params.EmailUsername = data.EmailUsername.ValueString()
 	}
 
-	endpoint := fmt.Sprintf("/api/accounts/%d/projects/%d/inboxes", accountID, data.ProjectID.ValueInt64())
-	
-	var inbox client.Inbox
-	err := r.client.Post(endpoint, createReq, &inbox)
+	inbox, err := r.client.Inboxes.Create(ctx, accountID, data.ProjectID.ValueInt64(), params)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create inbox, got error: %s", err))
 		return
 	}
 
 	// Update model with response data
-	r.updateModelFromInbox(ctx, &data, &inbox, accountID)
+	r.updateModelFromInbox(ctx, &data, inbox, accountID)
 
 	tflog.Trace(ctx, "created an inbox resource")
 
@@ -277,18 +294,33 @@ func (r *InboxResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get current inbox state
-	endpoint := fmt.Sprintf("/api/accounts/%d/inboxes/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	var inbox client.Inbox
-	err := r.client.Get(endpoint, &inbox)
+	inbox, err := r.client.Inboxes.Get(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Inbox Not Found",
+				fmt.Sprintf("Inbox %d no longer exists in Mailtrap and has been removed from the Terraform state. "+
+					"It was most likely deleted outside of Terraform; the next apply will recreate it.", data.ID.ValueInt64()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inbox, got error: %s", err))
 		return
 	}
 
 	// Update model with response data
-	r.updateModelFromInbox(ctx, &data, &inbox, data.AccountID.ValueInt64())
+	r.updateModelFromInbox(ctx, &data, inbox, data.AccountID.ValueInt64())
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -304,32 +336,33 @@ func (r *InboxResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Update API request
-	updateReq := client.InboxRequest{
-		Inbox: struct {
-			Name          string `json:"name"`
-			EmailUsername string `json:"email_username,omitempty"`
-		}{
-			Name: data.Name.ValueString(),
-		},
+	params := client.InboxParams{
+		Name: data.Name.ValueString(),
 	}
 
 	// Add email username if provided
 	if !data.EmailUsername.IsNull() && !data.EmailUsername.IsUnknown() {
-		updateReq.Inbox.EmailUsername = data.EmailUsername.ValueString()
+		params.EmailUsername = data.EmailUsername.ValueString()
 	}
 
-	endpoint := fmt.Sprintf("/api/accounts/%d/inboxes/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	var inbox client.Inbox
-	err := r.client.Patch(endpoint, updateReq, &inbox)
+	inbox, err := r.client.Inboxes.Update(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64(), params)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update inbox, got error: %s", err))
 		return
 	}
 
 	// Update model with response data
-	r.updateModelFromInbox(ctx, &data, &inbox, data.AccountID.ValueInt64())
+	r.updateModelFromInbox(ctx, &data, inbox, data.AccountID.ValueInt64())
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -345,10 +378,23 @@ func (r *InboxResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	endpoint := fmt.Sprintf("/api/accounts/%d/inboxes/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	err := r.client.Delete(endpoint, nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Inboxes.Delete(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
+		// The inbox is already gone: deleted outside of Terraform, or by an
+		// earlier attempt whose response was lost before the retry.
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "inbox already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete inbox, got error: %s", err))
 		return
 	}
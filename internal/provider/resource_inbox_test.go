@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
 )
 
 func TestInboxResource_Metadata(t *testing.T) {
@@ -166,4 +174,463 @@ func TestInboxResourceModel(t *testing.T) {
 	if model.Domain.ValueString() != "smtp.mailtrap.io" {
 		t.Errorf("Expected domain 'smtp.mailtrap.io', got %s", model.Domain.ValueString())
 	}
-}
\ No newline at end of file
+}
+
+func testInboxResourceModel() InboxResourceModel {
+	return InboxResourceModel{
+		ID:                      types.Int64Value(67890),
+		AccountID:               types.Int64Value(12345),
+		ProjectID:               types.Int64Value(111),
+		Name:                    types.StringValue("Old Inbox"),
+		Username:                types.StringNull(),
+		Password:                types.StringNull(),
+		EmailUsername:           types.StringNull(),
+		EmailUsernameEnabled:    types.BoolNull(),
+		Domain:                  types.StringNull(),
+		EmailDomain:             types.StringNull(),
+		POP3Domain:              types.StringNull(),
+		SMTPPorts:               types.ListNull(types.Int64Type),
+		POP3Ports:               types.ListNull(types.Int64Type),
+		Status:                  types.StringNull(),
+		MaxSize:                 types.Int64Null(),
+		SentMessagesCount:       types.Int64Null(),
+		ForwardedMessagesCount:  types.Int64Null(),
+		ForwardFromEmailAddress: types.StringNull(),
+		Timeouts:                testTimeouts(nil),
+	}
+}
+
+func TestInboxResource_Read(t *testing.T) {
+	r := &InboxResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/accounts/12345/inboxes/67890" {
+			t.Errorf("Unexpected request path %s", req.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":67890,"project_id":111,"name":"New Inbox","username":"smtp_user","password":"smtp_pass","smtp_ports":[25,465,587,2525]}`))
+	})
+
+	state := testResourceState(t, r, testInboxResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data InboxResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+
+	if data.Name.ValueString() != "New Inbox" {
+		t.Errorf("Expected name 'New Inbox', got %s", data.Name.ValueString())
+	}
+
+	if data.Password.ValueString() != "smtp_pass" {
+		t.Errorf("Expected password 'smtp_pass', got %s", data.Password.ValueString())
+	}
+
+	if len(data.SMTPPorts.Elements()) != 4 {
+		t.Errorf("Expected 4 SMTP ports, got %d", len(data.SMTPPorts.Elements()))
+	}
+}
+
+func TestInboxResource_Read_NotFound(t *testing.T) {
+	r := &InboxResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+
+	state := testResourceState(t, r, testInboxResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
+
+func TestInboxResource_Read_Error(t *testing.T) {
+	r := &InboxResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	state := testResourceState(t, r, testInboxResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected error for server error response")
+	}
+
+	if resp.State.Raw.IsNull() {
+		t.Error("Expected resource to remain in state")
+	}
+}
+
+func TestInboxResource_Delete_NotFound(t *testing.T) {
+	r := &InboxResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+
+	state := testResourceState(t, r, testInboxResourceModel())
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Expected a inbox deleted outside of Terraform to count as deleted, got %v", resp.Diagnostics.Errors())
+	}
+}
+
+// TestInboxResource_Delete_RetryAfterLostResponse covers a delete that succeeded
+// on the server but answered 503, so the retry finds the inbox gone.
+func TestInboxResource_Delete_RetryAfterLostResponse(t *testing.T) {
+	var methods []string
+	r := &InboxResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		methods = append(methods, req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if len(methods) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+	r.client.SetRetryPolicy(1, time.Millisecond)
+
+	state := testResourceState(t, r, testInboxResourceModel())
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+	if len(methods) != 2 || methods[0] != http.MethodDelete || methods[1] != http.MethodDelete {
+		t.Errorf("Expected the DELETE to be retried once, got %v", methods)
+	}
+}
+
+func TestAccInboxResource(t *testing.T) {
+	server, account := testAccServer(t)
+	resourceName := "mailtrap_inbox.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckInboxDestroy(server),
+		Steps: []tfresource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInboxResourceConfig(server, account.ID, "Acceptance Inbox", ""),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(resourceName, "name", "Acceptance Inbox"),
+					tfresource.TestCheckResourceAttrPair(resourceName, "project_id", "mailtrap_project.test", "id"),
+					tfresource.TestCheckResourceAttrSet(resourceName, "username"),
+					tfresource.TestCheckResourceAttrSet(resourceName, "password"),
+					tfresource.TestCheckResourceAttrSet(resourceName, "email_username"),
+					tfresource.TestCheckResourceAttr(resourceName, "status", "active"),
+					tfresource.TestCheckResourceAttr(resourceName, "smtp_ports.#", "4"),
+				),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccInboxResourceConfig(server, account.ID, "Renamed Inbox", "acc-inbox"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(resourceName, "name", "Renamed Inbox"),
+					tfresource.TestCheckResourceAttr(resourceName, "email_username", "acc-inbox"),
+				),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Dropping email_username from the configuration keeps the
+			// current value instead of planning a change on every run
+			{
+				Config:   testAccInboxResourceConfig(server, account.ID, "Renamed Inbox", ""),
+				PlanOnly: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccInboxResource_Drift(t *testing.T) {
+	server, account := testAccServer(t)
+	resourceName := "mailtrap_inbox.test"
+	config := testAccInboxResourceConfig(server, account.ID, "Acceptance Inbox", "")
+
+	var inboxID int
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckInboxDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &inboxID),
+			},
+			// A rename made in the Mailtrap UI is reverted
+			{
+				PreConfig: func() {
+					server.UpdateInbox(inboxID, func(inbox *client.Inbox) { inbox.Name = "Renamed In UI" })
+				},
+				Config: config,
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: tfresource.TestCheckResourceAttr(resourceName, "name", "Acceptance Inbox"),
+			},
+			// A deleted inbox is recreated
+			{
+				PreConfig: func() { server.RemoveInbox(inboxID) },
+				Config:    config,
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccInboxResourceConfig(server *fakemailtrap.Server, accountID int, name, emailUsername string) string {
+	emailUsernameAttr := ""
+	if emailUsername != "" {
+		emailUsernameAttr = fmt.Sprintf("email_username = %q", emailUsername)
+	}
+
+	return testAccProviderConfig(server, accountID) + fmt.Sprintf(`
+resource "mailtrap_project" "test" {
+  name = "Acceptance Project"
+}
+
+resource "mailtrap_inbox" "test" {
+  project_id = mailtrap_project.test.id
+  name       = %q
+  %s
+}
+`, name, emailUsernameAttr)
+}
+
+// testAccCheckInboxDestroy verifies every inbox in the state was deleted from
+// the fake API.
+func testAccCheckInboxDestroy(server *fakemailtrap.Server) tfresource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "mailtrap_inbox" {
+				continue
+			}
+
+			id, err := testAccResourceID(s, name)
+			if err != nil {
+				return err
+			}
+			if _, ok := server.Inbox(id); ok {
+				return fmt.Errorf("inbox %d still exists", id)
+			}
+		}
+		return nil
+	}
+}
+
+func TestInboxResource_Create_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	r := &InboxResource{accountID: 12345}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		<-release
+	})
+	// Registered after testClient so the handler returns before the server
+	// is closed.
+	t.Cleanup(func() { close(release) })
+
+	plan := testInboxResourceModel()
+	plan.ID = types.Int64Null()
+	plan.Timeouts = testTimeouts(map[string]string{"create": "100ms"})
+
+	resp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	start := time.Now()
+	r.Create(context.Background(), resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error once the create timeout passed")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the create timeout to cancel the request, took %s", elapsed)
+	}
+}
+
+// TestInboxResource_Cassette replays an inbox lifecycle recorded against
+// fakemailtrap: create, read, an update rejected for an invalid email
+// username, a rename, delete, and a read after deletion.
+func TestInboxResource_Cassette(t *testing.T) {
+	ctx := context.Background()
+	c := testCassetteClient(t, "fakemailtrap/inbox_lifecycle")
+	accountID := testCassetteAccountID(t, c)
+	r := &InboxResource{client: c, accountID: accountID}
+
+	project, err := c.Projects.Create(ctx, accountID, "Cassette Inboxes")
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	plan := testInboxResourceModel()
+	plan.ID = types.Int64Null()
+	plan.AccountID = types.Int64Null()
+	plan.ProjectID = types.Int64Value(int64(project.ID))
+	plan.Name = types.StringValue("Cassette Inbox")
+
+	createResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on create, got %v", createResp.Diagnostics.Errors())
+	}
+
+	var created InboxResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueInt64() == 0 || created.Password.ValueString() == "" || created.SMTPPorts.IsNull() {
+		t.Fatalf("Expected an inbox with credentials, got %+v", created)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on read, got %v", readResp.Diagnostics.Errors())
+	}
+
+	invalid := created
+	invalid.EmailUsername = types.StringValue("Not Valid")
+	invalidResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, invalid), State: readResp.State}, invalidResp)
+	if !invalidResp.Diagnostics.HasError() {
+		t.Error("Expected a validation error for an invalid email username")
+	}
+
+	renamed := created
+	renamed.Name = types.StringValue("Cassette Inbox Renamed")
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, renamed), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on update, got %v", updateResp.Diagnostics.Errors())
+	}
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on delete, got %v", deleteResp.Diagnostics.Errors())
+	}
+
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("Expected the deleted inbox to be removed from state, got %v", goneResp.Diagnostics)
+	}
+
+	if err := c.Projects.Delete(ctx, accountID, int64(project.ID)); err != nil {
+		t.Errorf("Failed to delete project: %v", err)
+	}
+}
+
+// TestInboxResource_DocumentedCassette replays an inbox session written from
+// Mailtrap's API reference: create, read, a read rejected with Mailtrap's
+// 403 body, delete, and a read after deletion.
+func TestInboxResource_DocumentedCassette(t *testing.T) {
+	ctx := context.Background()
+	c := testCassetteClient(t, "documented/inbox_lifecycle")
+	accountID := testCassetteAccountID(t, c)
+	r := &InboxResource{client: c, accountID: accountID}
+
+	project, err := c.Projects.Create(ctx, accountID, "My New Project")
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	if !project.Permissions.CanDestroy {
+		t.Errorf("Expected the project permissions to decode, got %+v", project.Permissions)
+	}
+
+	plan := testInboxResourceModel()
+	plan.ID = types.Int64Null()
+	plan.AccountID = types.Int64Null()
+	plan.ProjectID = types.Int64Value(int64(project.ID))
+	plan.Name = types.StringValue("Admin Inbox")
+
+	createResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on create, got %v", createResp.Diagnostics.Errors())
+	}
+
+	var created InboxResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueInt64() != 4015 || created.EmailUsername.ValueString() != "b7eae548c3-54c542" || created.SMTPPorts.IsNull() {
+		t.Fatalf("Expected inbox 4015 with its email username and ports, got %+v", created)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on read, got %v", readResp.Diagnostics.Errors())
+	}
+
+	forbiddenResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, forbiddenResp)
+	if !forbiddenResp.Diagnostics.HasError() {
+		t.Error("Expected an error for an inbox the token may not read")
+	} else if detail := forbiddenResp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "insufficient permissions") {
+		t.Errorf("Expected the error to carry Mailtrap's message, got %s", detail)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on delete, got %v", deleteResp.Diagnostics.Errors())
+	}
+
+	goneResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("Expected the deleted inbox to be removed from state, got %v", goneResp.Diagnostics)
+	}
+
+	if err := c.Projects.Delete(ctx, accountID, int64(project.ID)); err != nil {
+		t.Errorf("Failed to delete project: %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,14 +33,16 @@ func NewProjectResource() resource.Resource {
 type ProjectResource struct {
 	client    *client.Client
 	accountID int64
+	accounts  []client.Account
 }
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	ID         types.Int64  `tfsdk:"id"`
-	AccountID  types.Int64  `tfsdk:"account_id"`
-	Name       types.String `tfsdk:"name"`
-	ShareLinks types.Object `tfsdk:"share_links"`
+	ID         types.Int64    `tfsdk:"id"`
+	AccountID  types.Int64    `tfsdk:"account_id"`
+	Name       types.String   `tfsdk:"name"`
+	ShareLinks types.Object   `tfsdk:"share_links"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ShareLinksModel describes the share links data model
@@ -96,6 +99,15 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -116,6 +128,7 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 
 	r.client = providerData.Client
 	r.accountID = providerData.AccountID
+	r.accounts = providerData.Accounts
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -128,6 +141,15 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Determine account ID
 	accountID := r.accountID
 	if !data.AccountID.IsNull() && !data.AccountID.IsUnknown() {
@@ -137,24 +159,12 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	if accountID == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Account ID",
-			"Account ID must be provided either in the resource configuration or provider configuration",
+			missingAccountIDDetail("resource", r.accounts),
 		)
 		return
 	}
 
-	// Create API request
-	createReq := client.ProjectRequest{
-		Project: struct {
-			Name string `json:"name"`
-		}{
-			Name: data.Name.ValueString(),
-		},
-	}
-
-	endpoint := fmt.Sprintf("/api/accounts/%d/projects", accountID)
-	
-	var project client.Project
-	err := r.client.Post(endpoint, createReq, &project)
+	project, err := r.client.Projects.Create(ctx, accountID, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
 		return
@@ -196,12 +206,27 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get current project state
-	endpoint := fmt.Sprintf("/api/accounts/%d/projects/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	var project client.Project
-	err := r.client.Get(endpoint, &project)
+	project, err := r.client.Projects.Get(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Project Not Found",
+				fmt.Sprintf("Project %d no longer exists in Mailtrap and has been removed from the Terraform state. "+
+					"It was most likely deleted outside of Terraform; the next apply will recreate it.", data.ID.ValueInt64()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
 		return
 	}
@@ -238,19 +263,16 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// Update API request
-	updateReq := client.ProjectRequest{
-		Project: struct {
-			Name string `json:"name"`
-		}{
-			Name: data.Name.ValueString(),
-		},
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	endpoint := fmt.Sprintf("/api/accounts/%d/projects/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	var project client.Project
-	err := r.client.Patch(endpoint, updateReq, &project)
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	project, err := r.client.Projects.Update(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
 		return
@@ -288,10 +310,23 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	endpoint := fmt.Sprintf("/api/accounts/%d/projects/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	err := r.client.Delete(endpoint, nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Projects.Delete(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
+		// The project is already gone: deleted outside of Terraform, or by an
+		// earlier attempt whose response was lost before the retry.
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "project already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
 		return
 	}
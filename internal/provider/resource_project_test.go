@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
 )
 
 func TestProjectResource_Metadata(t *testing.T) {
@@ -193,4 +201,398 @@ func TestProjectResourceModel(t *testing.T) {
 	if model.ShareLinks.IsNull() {
 		t.Error("Expected share links to be set")
 	}
-}
\ No newline at end of file
+}
+
+func testProjectResourceModel() ProjectResourceModel {
+	return ProjectResourceModel{
+		ID:        types.Int64Value(67890),
+		AccountID: types.Int64Value(12345),
+		Name:      types.StringValue("Old Name"),
+		ShareLinks: types.ObjectNull(map[string]attr.Type{
+			"admin":  types.StringType,
+			"viewer": types.StringType,
+		}),
+		Timeouts: testTimeouts(nil),
+	}
+}
+
+func TestProjectResource_Read(t *testing.T) {
+	r := &ProjectResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/accounts/12345/projects/67890" {
+			t.Errorf("Unexpected request path %s", req.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":67890,"name":"New Name","share_links":{"admin":"https://admin.link","viewer":"https://viewer.link"}}`))
+	})
+
+	state := testResourceState(t, r, testProjectResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data ProjectResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+
+	if data.Name.ValueString() != "New Name" {
+		t.Errorf("Expected name 'New Name', got %s", data.Name.ValueString())
+	}
+}
+
+func TestProjectResource_Read_NotFound(t *testing.T) {
+	r := &ProjectResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+
+	state := testResourceState(t, r, testProjectResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
+
+func TestProjectResource_Read_Error(t *testing.T) {
+	r := &ProjectResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":"Access forbidden"}`))
+	})
+
+	state := testResourceState(t, r, testProjectResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected error for forbidden response")
+	}
+
+	if resp.State.Raw.IsNull() {
+		t.Error("Expected resource to remain in state")
+	}
+}
+
+func TestProjectResource_Delete_NotFound(t *testing.T) {
+	r := &ProjectResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+
+	state := testResourceState(t, r, testProjectResourceModel())
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Expected a project deleted outside of Terraform to count as deleted, got %v", resp.Diagnostics.Errors())
+	}
+}
+
+// TestProjectResource_Delete_RetryAfterLostResponse covers a delete that succeeded
+// on the server but answered 503, so the retry finds the project gone.
+func TestProjectResource_Delete_RetryAfterLostResponse(t *testing.T) {
+	var methods []string
+	r := &ProjectResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		methods = append(methods, req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if len(methods) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+	r.client.SetRetryPolicy(1, time.Millisecond)
+
+	state := testResourceState(t, r, testProjectResourceModel())
+	resp := &resource.DeleteResponse{State: state}
+
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+	if len(methods) != 2 || methods[0] != http.MethodDelete || methods[1] != http.MethodDelete {
+		t.Errorf("Expected the DELETE to be retried once, got %v", methods)
+	}
+}
+
+func TestAccProjectResource(t *testing.T) {
+	server, account := testAccServer(t)
+	resourceName := "mailtrap_project.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(server),
+		Steps: []tfresource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfig(server, account.ID, "Acceptance Project"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(resourceName, "name", "Acceptance Project"),
+					tfresource.TestCheckResourceAttr(resourceName, "account_id", fmt.Sprint(account.ID)),
+					tfresource.TestCheckResourceAttrSet(resourceName, "id"),
+					tfresource.TestCheckResourceAttrSet(resourceName, "share_links.admin"),
+					tfresource.TestCheckResourceAttrSet(resourceName, "share_links.viewer"),
+				),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectResourceConfig(server, account.ID, "Renamed Project"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(resourceName, "name", "Renamed Project"),
+				),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccProjectResource_DeletedOutsideTerraform(t *testing.T) {
+	server, account := testAccServer(t)
+	resourceName := "mailtrap_project.test"
+	config := testAccProjectResourceConfig(server, account.ID, "Acceptance Project")
+
+	var projectID int
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &projectID),
+			},
+			// The project is recreated after it disappears from Mailtrap
+			{
+				PreConfig: func() { server.RemoveProject(projectID) },
+				Config:    config,
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: tfresource.TestCheckResourceAttr(resourceName, "name", "Acceptance Project"),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfig(server *fakemailtrap.Server, accountID int, name string) string {
+	return testAccProviderConfig(server, accountID) + fmt.Sprintf(`
+resource "mailtrap_project" "test" {
+  name = %q
+}
+`, name)
+}
+
+// testAccCheckProjectDestroy verifies every project in the state was deleted
+// from the fake API.
+func testAccCheckProjectDestroy(server *fakemailtrap.Server) tfresource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "mailtrap_project" {
+				continue
+			}
+
+			id, err := testAccResourceID(s, name)
+			if err != nil {
+				return err
+			}
+			if _, ok := server.Project(id); ok {
+				return fmt.Errorf("project %d still exists", id)
+			}
+		}
+		return nil
+	}
+}
+
+func TestProjectResource_Read_TimeoutIncludesRetries(t *testing.T) {
+	attempts := 0
+	r := &ProjectResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	r.client.SetRetryPolicy(10, 30*time.Second)
+
+	model := testProjectResourceModel()
+	model.Timeouts = testTimeouts(map[string]string{"read": "1500ms"})
+	state := testResourceState(t, r, model)
+	resp := &resource.ReadResponse{State: state}
+
+	start := time.Now()
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	elapsed := time.Since(start)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error once the read timeout passed")
+	}
+	if elapsed > 5*time.Second {
+		t.Errorf("Expected the read timeout to stop retries, took %s", elapsed)
+	}
+	if attempts < 2 {
+		t.Errorf("Expected the request to be retried within the timeout, got %d attempts", attempts)
+	}
+}
+
+// TestProjectResource_Cassette replays a project lifecycle recorded against
+// fakemailtrap: create, read, rename, a rejected rename, delete, and a read
+// after deletion.
+func TestProjectResource_Cassette(t *testing.T) {
+	ctx := context.Background()
+	c := testCassetteClient(t, "fakemailtrap/project_lifecycle")
+	r := &ProjectResource{client: c, accountID: testCassetteAccountID(t, c)}
+
+	plan := testProjectResourceModel()
+	plan.ID = types.Int64Null()
+	plan.AccountID = types.Int64Null()
+	plan.Name = types.StringValue("Cassette Project")
+
+	createResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on create, got %v", createResp.Diagnostics.Errors())
+	}
+
+	var created ProjectResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueInt64() == 0 || created.ShareLinks.IsNull() {
+		t.Fatalf("Expected a project ID and share links, got %+v", created)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on read, got %v", readResp.Diagnostics.Errors())
+	}
+
+	renamed := created
+	renamed.Name = types.StringValue("Cassette Project Renamed")
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, renamed), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on update, got %v", updateResp.Diagnostics.Errors())
+	}
+
+	var updated ProjectResourceModel
+	updateResp.State.Get(ctx, &updated)
+	if updated.Name.ValueString() != "Cassette Project Renamed" {
+		t.Errorf("Expected the renamed project, got %s", updated.Name.ValueString())
+	}
+
+	invalid := updated
+	invalid.Name = types.StringValue("X")
+	invalidResp := &resource.UpdateResponse{State: updateResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: testResourcePlan(t, r, invalid), State: updateResp.State}, invalidResp)
+	if !invalidResp.Diagnostics.HasError() {
+		t.Error("Expected a validation error for a one character name")
+	}
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on delete, got %v", deleteResp.Diagnostics.Errors())
+	}
+
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("Expected the deleted project to be removed from state, got %v", goneResp.Diagnostics)
+	}
+}
+
+// TestProjectResource_DocumentedCassette replays a project session written
+// from Mailtrap's API reference: create, read, a read rejected with
+// Mailtrap's 401 body, delete, and a read after deletion.
+func TestProjectResource_DocumentedCassette(t *testing.T) {
+	ctx := context.Background()
+	c := testCassetteClient(t, "documented/project_lifecycle")
+	r := &ProjectResource{client: c, accountID: testCassetteAccountID(t, c)}
+
+	plan := testProjectResourceModel()
+	plan.ID = types.Int64Null()
+	plan.AccountID = types.Int64Null()
+	plan.Name = types.StringValue("My New Project")
+
+	createResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on create, got %v", createResp.Diagnostics.Errors())
+	}
+
+	var created ProjectResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueInt64() != 4046 || created.ShareLinks.IsNull() {
+		t.Fatalf("Expected project 4046 with share links, got %+v", created)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on read, got %v", readResp.Diagnostics.Errors())
+	}
+
+	unauthorizedResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, unauthorizedResp)
+	if !unauthorizedResp.Diagnostics.HasError() {
+		t.Error("Expected an error for a rejected API token")
+	} else if detail := unauthorizedResp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "Incorrect API token") {
+		t.Errorf("Expected the error to carry Mailtrap's message, got %s", detail)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on delete, got %v", deleteResp.Diagnostics.Errors())
+	}
+
+	goneResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("Expected the deleted project to be removed from state, got %v", goneResp.Diagnostics)
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,18 +36,20 @@ func NewSendingDomainResource() resource.Resource {
 type SendingDomainResource struct {
 	client    *client.Client
 	accountID int64
+	accounts  []client.Account
 }
 
 // SendingDomainResourceModel describes the resource data model.
 type SendingDomainResourceModel struct {
-	ID               types.Int64  `tfsdk:"id"`
-	AccountID        types.Int64  `tfsdk:"account_id"`
-	Name             types.String `tfsdk:"name"`
-	CNAME            types.String `tfsdk:"cname"`
-	Status           types.String `tfsdk:"status"`
-	ComplianceStatus types.String `tfsdk:"compliance_status"`
-	DNSRecords       types.Object `tfsdk:"dns_records"`
-	DNSStatus        types.Object `tfsdk:"dns_status"`
+	ID               types.Int64    `tfsdk:"id"`
+	AccountID        types.Int64    `tfsdk:"account_id"`
+	Name             types.String   `tfsdk:"name"`
+	CNAME            types.String   `tfsdk:"cname"`
+	Status           types.String   `tfsdk:"status"`
+	ComplianceStatus types.String   `tfsdk:"compliance_status"`
+	DNSRecords       types.Object   `tfsdk:"dns_records"`
+	DNSStatus        types.Object   `tfsdk:"dns_status"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // DNSRecordsModel describes the DNS records structure
@@ -254,6 +256,15 @@ func (r *SendingDomainResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -274,6 +285,7 @@ func (r *SendingDomainResource) Configure(ctx context.Context, req resource.Conf
 
 	r.client = providerData.Client
 	r.accountID = providerData.AccountID
+	r.accounts = providerData.Accounts
 }
 
 func (r *SendingDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -286,6 +298,15 @@ func (r *SendingDomainResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Determine account ID
 	accountID := r.accountID
 	if !data.AccountID.IsNull() && !data.AccountID.IsUnknown() {
@@ -295,24 +316,12 @@ func (r *SendingDomainResource) Create(ctx context.Context, req resource.CreateR
 	if accountID == 0 {
 		resp.Diagnostics.AddError(
 			"Missing Account ID",
-			"Account ID must be provided either in the resource configuration or provider configuration",
+			missingAccountIDDetail("resource", r.accounts),
 		)
 		return
 	}
 
-	// Create API request
-	createReq := client.SendingDomainRequest{
-		SendingDomain: struct {
-			DomainName string `json:"domain_name"`
-		}{
-			DomainName: data.Name.ValueString(),
-		},
-	}
-
-	endpoint := fmt.Sprintf("/api/accounts/%d/sending_domains", accountID)
-	
-	var domain client.SendingDomain
-	err := r.client.Post(endpoint, createReq, &domain)
+	domain, err := r.client.SendingDomains.Create(ctx, accountID, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sending domain, got error: %s", err))
 		return
@@ -327,7 +336,7 @@ func (r *SendingDomainResource) Create(ctx context.Context, req resource.CreateR
 	data.ComplianceStatus = types.StringValue(domain.ComplianceStatus)
 
 	// Convert DNS records
-	dnsRecordsValue, diags := r.convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
+	dnsRecordsValue, diags := convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -366,12 +375,27 @@ func (r *SendingDomainResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get current domain state
-	endpoint := fmt.Sprintf("/api/accounts/%d/sending_domains/%d", data.AccountID.ValueInt64(), data.ID.ValueInt64())
-	
-	var domain client.SendingDomain
-	err := r.client.Get(endpoint, &domain)
+	domain, err := r.client.SendingDomains.Get(ctx, data.AccountID.ValueInt64(), data.ID.ValueInt64())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Sending Domain Not Found",
+				fmt.Sprintf("Sending domain %d no longer exists in Mailtrap and has been removed from the Terraform state. "+
+					"It was most likely deleted outside of Terraform; the next apply will recreate it.", data.ID.ValueInt64()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sending domain, got error: %s", err))
 		return
 	}
@@ -383,7 +407,7 @@ func (r *SendingDomainResource) Read(ctx context.Context, req resource.ReadReque
 	data.ComplianceStatus = types.StringValue(domain.ComplianceStatus)
 
 	// Convert DNS records
-	dnsRecordsValue, diags := r.convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
+	dnsRecordsValue, diags := convertDNSRecordsToTerraform(ctx, &domain.DNSRecords)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -411,9 +435,31 @@ func (r *SendingDomainResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *SendingDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SendingDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Sending domains don't support updates through the API
-	// Just read the current state
+	// Just read the current state, keeping the planned timeouts
 	r.Read(ctx, resource.ReadRequest{State: req.State}, (*resource.ReadResponse)(resp))
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), data.Timeouts)...)
 }
 
 func (r *SendingDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -427,7 +473,7 @@ func (r *SendingDomainResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// The API doesn't provide a delete endpoint for sending domains
-	// We'll just remove it from state
+	// We'll just remove it from state, so the delete timeout has nothing to bound
 	tflog.Warn(ctx, "Sending domains cannot be deleted via API. The domain will be removed from Terraform state but will remain in Mailtrap.")
 }
 
@@ -463,106 +509,3 @@ func (r *SendingDomainResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), accountID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), domainID)...)
 }
-
-// Helper function to convert DNS records to Terraform types
-func (r *SendingDomainResource) convertDNSRecordsToTerraform(ctx context.Context, records *client.DNSRecords) (types.Object, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	// Define the attribute types for a DNS record
-	dnsRecordAttrTypes := map[string]attr.Type{
-		"priority":    types.Int64Type,
-		"record_type": types.StringType,
-		"hostname":    types.StringType,
-		"value":       types.StringType,
-		"status":      types.StringType,
-	}
-
-	// Convert CNAME records
-	cnameRecords := make([]attr.Value, len(records.CNAME))
-	for i, record := range records.CNAME {
-		var priority types.Int64
-		if record.Priority != nil {
-			priority = types.Int64Value(int64(*record.Priority))
-		} else {
-			priority = types.Int64Null()
-		}
-
-		recordObj, d := types.ObjectValueFrom(ctx, dnsRecordAttrTypes, DNSRecordModel{
-			Priority:   priority,
-			RecordType: types.StringValue(record.RecordType),
-			Hostname:   types.StringValue(record.Hostname),
-			Value:      types.StringValue(record.Value),
-			Status:     types.StringValue(record.Status),
-		})
-		diags.Append(d...)
-		cnameRecords[i] = recordObj
-	}
-
-	// Convert MX records
-	mxRecords := make([]attr.Value, len(records.MX))
-	for i, record := range records.MX {
-		var priority types.Int64
-		if record.Priority != nil {
-			priority = types.Int64Value(int64(*record.Priority))
-		} else {
-			priority = types.Int64Null()
-		}
-
-		recordObj, d := types.ObjectValueFrom(ctx, dnsRecordAttrTypes, DNSRecordModel{
-			Priority:   priority,
-			RecordType: types.StringValue(record.RecordType),
-			Hostname:   types.StringValue(record.Hostname),
-			Value:      types.StringValue(record.Value),
-			Status:     types.StringValue(record.Status),
-		})
-		diags.Append(d...)
-		mxRecords[i] = recordObj
-	}
-
-	// Convert TXT records
-	txtRecords := make([]attr.Value, len(records.TXT))
-	for i, record := range records.TXT {
-		var priority types.Int64
-		if record.Priority != nil {
-			priority = types.Int64Value(int64(*record.Priority))
-		} else {
-			priority = types.Int64Null()
-		}
-
-		recordObj, d := types.ObjectValueFrom(ctx, dnsRecordAttrTypes, DNSRecordModel{
-			Priority:   priority,
-			RecordType: types.StringValue(record.RecordType),
-			Hostname:   types.StringValue(record.Hostname),
-			Value:      types.StringValue(record.Value),
-			Status:     types.StringValue(record.Status),
-		})
-		diags.Append(d...)
-		txtRecords[i] = recordObj
-	}
-
-	// Create lists
-	cnameList, d := types.ListValue(types.ObjectType{AttrTypes: dnsRecordAttrTypes}, cnameRecords)
-	diags.Append(d...)
-	
-	mxList, d := types.ListValue(types.ObjectType{AttrTypes: dnsRecordAttrTypes}, mxRecords)
-	diags.Append(d...)
-	
-	txtList, d := types.ListValue(types.ObjectType{AttrTypes: dnsRecordAttrTypes}, txtRecords)
-	diags.Append(d...)
-
-	// Create the DNS records object
-	dnsRecordsAttrTypes := map[string]attr.Type{
-		"cname": types.ListType{ElemType: types.ObjectType{AttrTypes: dnsRecordAttrTypes}},
-		"mx":    types.ListType{ElemType: types.ObjectType{AttrTypes: dnsRecordAttrTypes}},
-		"txt":   types.ListType{ElemType: types.ObjectType{AttrTypes: dnsRecordAttrTypes}},
-	}
-
-	dnsRecordsObj, d := types.ObjectValue(dnsRecordsAttrTypes, map[string]attr.Value{
-		"cname": cnameList,
-		"mx":    mxList,
-		"txt":   txtList,
-	})
-	diags.Append(d...)
-
-	return dnsRecordsObj, diags
-}
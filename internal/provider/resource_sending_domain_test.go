@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yanchuk/mailtrap-terraform/internal/client"
+	"github.com/yanchuk/mailtrap-terraform/internal/fakemailtrap"
 )
 
 func TestSendingDomainResource_Metadata(t *testing.T) {
@@ -259,4 +266,367 @@ func TestSendingDomainResourceModel(t *testing.T) {
 	if model.DNSStatus.IsNull() {
 		t.Error("Expected DNS status to be set")
 	}
-}
\ No newline at end of file
+}
+
+func testSendingDomainResourceModel() SendingDomainResourceModel {
+	dnsRecordAttrTypes := map[string]attr.Type{
+		"priority":    types.Int64Type,
+		"record_type": types.StringType,
+		"hostname":    types.StringType,
+		"value":       types.StringType,
+		"status":      types.StringType,
+	}
+
+	return SendingDomainResourceModel{
+		ID:               types.Int64Value(67890),
+		AccountID:        types.Int64Value(12345),
+		Name:             types.StringValue("example.com"),
+		CNAME:            types.StringNull(),
+		Status:           types.StringNull(),
+		ComplianceStatus: types.StringNull(),
+		DNSRecords: types.ObjectNull(map[string]attr.Type{
+			"cname": types.ListType{ElemType: types.ObjectType{AttrTypes: dnsRecordAttrTypes}},
+			"mx":    types.ListType{ElemType: types.ObjectType{AttrTypes: dnsRecordAttrTypes}},
+			"txt":   types.ListType{ElemType: types.ObjectType{AttrTypes: dnsRecordAttrTypes}},
+		}),
+		DNSStatus: types.ObjectNull(map[string]attr.Type{
+			"cname": types.BoolType,
+			"mx":    types.BoolType,
+			"txt":   types.BoolType,
+		}),
+		Timeouts: testTimeouts(nil),
+	}
+}
+
+func TestSendingDomainResource_Read(t *testing.T) {
+	r := &SendingDomainResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/accounts/12345/sending_domains/67890" {
+			t.Errorf("Unexpected request path %s", req.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":67890,"name":"example.com","status":"verified","compliance_status":"compliant","dns_records":{"mx":[{"priority":10,"record_type":"MX","hostname":"example.com","value":"mx.mailtrap.live","status":"pass"}]},"dns_status":{"mx":true}}`))
+	})
+
+	state := testResourceState(t, r, testSendingDomainResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data SendingDomainResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+
+	if data.Status.ValueString() != "verified" {
+		t.Errorf("Expected status 'verified', got %s", data.Status.ValueString())
+	}
+
+	if data.DNSRecords.IsNull() {
+		t.Error("Expected DNS records to be set")
+	}
+}
+
+func TestSendingDomainResource_Read_NotFound(t *testing.T) {
+	r := &SendingDomainResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	})
+
+	state := testResourceState(t, r, testSendingDomainResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected resource to be removed from state")
+	}
+}
+
+func TestSendingDomainResource_Read_Error(t *testing.T) {
+	r := &SendingDomainResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":"Unauthorized"}`))
+	})
+
+	state := testResourceState(t, r, testSendingDomainResourceModel())
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected error for unauthorized response")
+	}
+
+	if resp.State.Raw.IsNull() {
+		t.Error("Expected resource to remain in state")
+	}
+}
+
+func TestAccSendingDomainResource(t *testing.T) {
+	server, account := testAccServer(t)
+	resourceName := "mailtrap_sending_domain.test"
+
+	var domainID int
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSendingDomainRetained(server),
+		Steps: []tfresource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSendingDomainResourceConfig(server, account.ID, "acceptance.example.com"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					testAccCaptureID(resourceName, &domainID),
+					tfresource.TestCheckResourceAttr(resourceName, "name", "acceptance.example.com"),
+					tfresource.TestCheckResourceAttr(resourceName, "status", "pending"),
+					tfresource.TestCheckResourceAttrSet(resourceName, "cname"),
+					tfresource.TestCheckResourceAttr(resourceName, "dns_records.cname.#", "3"),
+					tfresource.TestCheckResourceAttr(resourceName, "dns_records.txt.#", "2"),
+				),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			// Verification in Mailtrap is picked up on refresh without a diff
+			{
+				PreConfig: func() {
+					server.UpdateSendingDomain(domainID, func(domain *client.SendingDomain) {
+						domain.Status = "verified"
+						domain.ComplianceStatus = "compliant"
+						domain.DNSStatus = client.DNSStatus{CNAME: true, MX: true, TXT: true}
+					})
+				},
+				Config: testAccSendingDomainResourceConfig(server, account.ID, "acceptance.example.com"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr(resourceName, "status", "verified"),
+					tfresource.TestCheckResourceAttr(resourceName, "compliance_status", "compliant"),
+				),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Renaming the domain replaces it
+			{
+				Config: testAccSendingDomainResourceConfig(server, account.ID, "renamed.example.com"),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: tfresource.TestCheckResourceAttr(resourceName, "name", "renamed.example.com"),
+			},
+		},
+	})
+}
+
+func TestAccSendingDomainResource_Timeouts(t *testing.T) {
+	server, account := testAccServer(t)
+	resourceName := "mailtrap_sending_domain.test"
+
+	tfresource.Test(t, tfresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSendingDomainRetained(server),
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccSendingDomainResourceTimeoutsConfig(server, account.ID, "5m"),
+				Check:  tfresource.TestCheckResourceAttr(resourceName, "timeouts.create", "5m"),
+			},
+			// Changing only the timeouts updates the state in place
+			{
+				Config: testAccSendingDomainResourceTimeoutsConfig(server, account.ID, "30m"),
+				ConfigPlanChecks: tfresource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: tfresource.TestCheckResourceAttr(resourceName, "timeouts.create", "30m"),
+			},
+		},
+	})
+}
+
+func testAccSendingDomainResourceConfig(server *fakemailtrap.Server, accountID int, name string) string {
+	return testAccProviderConfig(server, accountID) + fmt.Sprintf(`
+resource "mailtrap_sending_domain" "test" {
+  name = %q
+}
+`, name)
+}
+
+func testAccSendingDomainResourceTimeoutsConfig(server *fakemailtrap.Server, accountID int, create string) string {
+	return testAccProviderConfig(server, accountID) + fmt.Sprintf(`
+resource "mailtrap_sending_domain" "test" {
+  name = "timeouts.example.com"
+
+  timeouts {
+    create = %q
+    read   = "2m"
+  }
+}
+`, create)
+}
+
+// testAccCheckSendingDomainRetained verifies that destroying a sending domain
+// only removes it from the state. Mailtrap has no API to delete one.
+func testAccCheckSendingDomainRetained(server *fakemailtrap.Server) tfresource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if rs.Type != "mailtrap_sending_domain" {
+				continue
+			}
+
+			id, err := testAccResourceID(s, name)
+			if err != nil {
+				return err
+			}
+			if _, ok := server.SendingDomain(id); !ok {
+				return fmt.Errorf("sending domain %d was unexpectedly deleted from Mailtrap", id)
+			}
+		}
+		return nil
+	}
+}
+
+func TestSendingDomainResource_Update_KeepsPlannedTimeouts(t *testing.T) {
+	r := &SendingDomainResource{}
+	r.client = testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":67890,"name":"example.com","status":"pending","compliance_status":"pending","dns_records":{},"dns_status":{}}`))
+	})
+
+	state := testResourceState(t, r, testSendingDomainResourceModel())
+	planned := testSendingDomainResourceModel()
+	planned.Timeouts = testTimeouts(map[string]string{"read": "5m"})
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: testResourcePlan(t, r, planned), State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, got %v", resp.Diagnostics.Errors())
+	}
+
+	var data SendingDomainResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+
+	if !data.Timeouts.Equal(planned.Timeouts) {
+		t.Errorf("Expected the planned timeouts in state, got %s", data.Timeouts)
+	}
+}
+
+// TestSendingDomainResource_Cassette replays a sending domain session
+// recorded against fakemailtrap: create, read, a duplicate create rejected
+// by Mailtrap, and a read of a domain that no longer exists.
+func TestSendingDomainResource_Cassette(t *testing.T) {
+	ctx := context.Background()
+	c := testCassetteClient(t, "fakemailtrap/sending_domain_lifecycle")
+	r := &SendingDomainResource{client: c, accountID: testCassetteAccountID(t, c)}
+
+	plan := testSendingDomainResourceModel()
+	plan.ID = types.Int64Null()
+	plan.AccountID = types.Int64Null()
+	plan.Name = types.StringValue("cassette.example.com")
+
+	createResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on create, got %v", createResp.Diagnostics.Errors())
+	}
+
+	var created SendingDomainResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueInt64() == 0 || created.DNSRecords.IsNull() {
+		t.Fatalf("Expected a sending domain with DNS records, got %+v", created)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors on read, got %v", readResp.Diagnostics.Errors())
+	}
+
+	duplicateResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, duplicateResp)
+	if !duplicateResp.Diagnostics.HasError() {
+		t.Error("Expected an error when creating the same domain twice")
+	}
+
+	missing := created
+	missing.ID = types.Int64Value(created.ID.ValueInt64() + 1000)
+	missingState := testResourceState(t, r, missing)
+	goneResp := &resource.ReadResponse{State: missingState}
+	r.Read(ctx, resource.ReadRequest{State: missingState}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("Expected the missing domain to be removed from state, got %v", goneResp.Diagnostics)
+	}
+}
+
+// TestSendingDomainResource_DocumentedCassette replays the sending domain
+// error responses documented in Mailtrap's API reference: a duplicate
+// create, a rejected API token, and a domain that no longer exists.
+func TestSendingDomainResource_DocumentedCassette(t *testing.T) {
+	ctx := context.Background()
+	c := testCassetteClient(t, "documented/sending_domain_errors")
+	r := &SendingDomainResource{client: c, accountID: testCassetteAccountID(t, c)}
+
+	plan := testSendingDomainResourceModel()
+	plan.ID = types.Int64Null()
+	plan.AccountID = types.Int64Null()
+	plan.Name = types.StringValue("mailtrap.io")
+
+	duplicateResp := &resource.CreateResponse{State: testEmptyState(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: testResourcePlan(t, r, plan)}, duplicateResp)
+	if !duplicateResp.Diagnostics.HasError() {
+		t.Error("Expected an error when creating a domain that is already taken")
+	} else if detail := duplicateResp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "Domain name has already been taken") {
+		t.Errorf("Expected the error to carry Mailtrap's message, got %s", detail)
+	}
+
+	existing := testSendingDomainResourceModel()
+	existing.ID = types.Int64Value(432)
+	existing.AccountID = types.Int64Value(26730)
+	existing.Name = types.StringValue("mailtrap.io")
+	state := testResourceState(t, r, existing)
+
+	unauthorizedResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, unauthorizedResp)
+	if !unauthorizedResp.Diagnostics.HasError() {
+		t.Error("Expected an error for a rejected API token")
+	} else if detail := unauthorizedResp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "Incorrect API token") {
+		t.Errorf("Expected the error to carry Mailtrap's message, got %s", detail)
+	}
+
+	goneResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("Expected the missing domain to be removed from state, got %v", goneResp.Diagnostics)
+	}
+}